@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+)
+
+// Stats records how a cache has been used. Hits and misses are counted by Get
+// and GetOrLoad; evictions count entries removed due to capacity or expiry.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// Calls onEvict for each evicted entry. Callers gather entries while holding
+// their lock and call this afterwards so that the callback may use the cache.
+func notifyEvicted[K comparable, V any](onEvict func(K, V), evicted []entry[K, V]) {
+	if onEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		onEvict(e.key, e.value)
+	}
+}
+
+// Ensures that when several goroutines request the same missing key at once,
+// the loader is only called once and all of them receive its result.
+type loadGroup[K comparable, V any] struct {
+	mutex sync.Mutex
+	calls map[K]*loadCall[V]
+}
+
+type loadCall[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+}
+
+func (g *loadGroup[K, V]) do(key K, load func() (V, error)) (V, error) {
+	g.mutex.Lock()
+	if g.calls == nil {
+		g.calls = map[K]*loadCall[V]{}
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mutex.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+	// if load panics, this is what the waiting goroutines receive
+	call := &loadCall[V]{err: errLoadPanicked}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mutex.Unlock()
+
+	// deferred so that a panicking load doesn't leave later callers blocked
+	defer func() {
+		g.mutex.Lock()
+		delete(g.calls, key)
+		g.mutex.Unlock()
+		call.wg.Done()
+	}()
+
+	call.value, call.err = load()
+	return call.value, call.err
+}
+
+var errLoadPanicked = errors.New("cache: load panicked")
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/jesseduffield/generics/orderedmap"
+)
+
+// LRU is a goroutine-safe cache holding at most a fixed number of entries.
+// When full, adding a new entry evicts the least recently used one.
+type LRU[K comparable, V any] struct {
+	mutex    sync.Mutex
+	capacity int
+	// ordered from least to most recently used
+	entries *orderedmap.OrderedMap[K, V]
+	onEvict func(K, V)
+	stats   Stats
+	loads   loadGroup[K, V]
+}
+
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity <= 0 {
+		panic("cache: LRU capacity must be positive")
+	}
+	return &LRU[K, V]{
+		capacity: capacity,
+		entries:  orderedmap.NewWithCapacity[K, V](capacity),
+	}
+}
+
+// Sets a callback to be called whenever an entry is evicted to make room for
+// another. It is not called for entries removed by Delete.
+func (c *LRU[K, V]) SetOnEvict(f func(key K, value V)) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.onEvict = f
+}
+
+// Returns the value for the key, marking it as most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	value, ok := c.entries.Get(key)
+	if !ok {
+		c.stats.Misses++
+		return value, false
+	}
+	c.stats.Hits++
+	c.entries.MoveToBack(key)
+	return value, true
+}
+
+// Like Get but does not affect recency or statistics.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.entries.Get(key)
+}
+
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mutex.Lock()
+	evicted := c.set(key, value)
+	onEvict := c.onEvict
+	c.mutex.Unlock()
+
+	notifyEvicted(onEvict, evicted)
+}
+
+// must be called with the lock held
+func (c *LRU[K, V]) set(key K, value V) []entry[K, V] {
+	if c.entries.Has(key) {
+		c.entries.Set(key, value)
+		c.entries.MoveToBack(key)
+		return nil
+	}
+
+	var evicted []entry[K, V]
+	if c.entries.Len() >= c.capacity {
+		c.entries.FromOldest()(func(oldestKey K, oldestValue V) bool {
+			evicted = append(evicted, entry[K, V]{key: oldestKey, value: oldestValue})
+			return false
+		})
+		c.entries.Delete(evicted[0].key)
+		c.stats.Evictions++
+	}
+	c.entries.Set(key, value)
+	return evicted
+}
+
+// Removes the key, returning whether it was present.
+func (c *LRU[K, V]) Delete(key K) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.entries.Delete(key)
+}
+
+func (c *LRU[K, V]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.entries.Len()
+}
+
+func (c *LRU[K, V]) Stats() Stats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.stats
+}
+
+// Returns the cached value for the key, calling load to obtain and cache it if
+// missing. Concurrent calls for the same missing key share a single call to
+// load. Errors from load are returned and nothing is cached. If load panics,
+// the panic propagates to its caller and any waiting callers get an error.
+func (c *LRU[K, V]) GetOrLoad(key K, load func() (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	return c.loads.do(key, func() (V, error) {
+		// another goroutine may have loaded the value since our Get
+		if value, ok := c.Peek(key); ok {
+			return value, nil
+		}
+		value, err := load()
+		if err != nil {
+			return value, err
+		}
+		c.Set(key, value)
+		return value, nil
+	})
+}
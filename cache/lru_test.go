@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestLRUEviction(t *testing.T) {
+	c := NewLRU[string, int](2)
+	evictedKeys := []string{}
+	c.SetOnEvict(func(key string, value int) { evictedKeys = append(evictedKeys, key) })
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	// makes "b" the least recently used
+	c.Get("a")
+	c.Set("c", 3)
+
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("expected b to be evicted")
+	}
+	if value, ok := c.Peek("a"); !ok || value != 1 {
+		t.Errorf("Peek(a) = %v, %v, expected %v, %v", value, ok, 1, true)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %v, expected %v", c.Len(), 2)
+	}
+	testutils.ExpectSlice(t, []string{"b"}, evictedKeys)
+
+	// updating an existing key marks it as recently used without evicting
+	c.Set("a", 4)
+	c.Set("d", 5)
+	testutils.ExpectSlice(t, []string{"b", "c"}, evictedKeys)
+}
+
+func TestLRUDelete(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Set("a", 1)
+
+	if !c.Delete("a") {
+		t.Errorf("Delete(a) = false, expected true")
+	}
+	if c.Delete("a") {
+		t.Errorf("Delete(a) = true, expected false")
+	}
+}
+
+func TestLRUStats(t *testing.T) {
+	c := NewLRU[string, int](1)
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("b")
+	c.Set("b", 2)
+
+	expected := Stats{Hits: 1, Misses: 1, Evictions: 1}
+	if c.Stats() != expected {
+		t.Errorf("Stats() = %+v, expected %+v", c.Stats(), expected)
+	}
+}
+
+func TestLRUGetOrLoad(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	value, err := c.GetOrLoad("a", func() (int, error) { return 1, nil })
+	testutils.ExpectNilError(t, err)
+	if value != 1 {
+		t.Errorf("GetOrLoad(a) = %v, expected %v", value, 1)
+	}
+
+	value, err = c.GetOrLoad("a", func() (int, error) { return 2, nil })
+	testutils.ExpectNilError(t, err)
+	if value != 1 {
+		t.Errorf("GetOrLoad(a) = %v, expected %v", value, 1)
+	}
+
+	_, err = c.GetOrLoad("b", func() (int, error) { return 0, errors.New("failed") })
+	testutils.ExpectError(t, err, "failed")
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("expected failed load not to be cached")
+	}
+}
+
+func TestLRUGetOrLoadPanic(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	func() {
+		defer testutils.ExpectPanic(t)
+		_, _ = c.GetOrLoad("a", func() (int, error) { panic("failed") })
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		value, err := c.GetOrLoad("a", func() (int, error) { return 1, nil })
+		testutils.ExpectNilError(t, err)
+		if value != 1 {
+			t.Errorf("GetOrLoad(a) = %v, expected %v", value, 1)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("GetOrLoad blocked after an earlier load panicked")
+	}
+}
+
+func TestLRUGetOrLoadDeduplicates(t *testing.T) {
+	c := NewLRU[string, int](2)
+	var loadCount int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := c.GetOrLoad("a", func() (int, error) {
+				atomic.AddInt32(&loadCount, 1)
+				<-release
+				return 1, nil
+			})
+			testutils.ExpectNilError(t, err)
+			if value != 1 {
+				t.Errorf("GetOrLoad(a) = %v, expected %v", value, 1)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if loadCount != 1 {
+		t.Errorf("load called %v times, expected %v", loadCount, 1)
+	}
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// TTL is a goroutine-safe cache whose entries expire after a given duration.
+// Expired entries are removed lazily when accessed, or eagerly by
+// DeleteExpired.
+type TTL[K comparable, V any] struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[K]ttlEntry[V]
+	now     func() time.Time
+	onEvict func(K, V)
+	stats   Stats
+	loads   loadGroup[K, V]
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// Creates a cache in which entries added with Set expire after the given ttl.
+func NewTTL[K comparable, V any](ttl time.Duration) *TTL[K, V] {
+	return &TTL[K, V]{
+		ttl:     ttl,
+		entries: map[K]ttlEntry[V]{},
+		now:     time.Now,
+	}
+}
+
+// Sets the function used to obtain the current time, which defaults to
+// time.Now. Intended for tests.
+func (c *TTL[K, V]) SetClock(now func() time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = now
+}
+
+// Sets a callback to be called whenever an expired entry is removed. It is not
+// called for entries removed by Delete.
+func (c *TTL[K, V]) SetOnEvict(f func(key K, value V)) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.onEvict = f
+}
+
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mutex.Lock()
+	value, ok, evicted := c.get(key)
+	if ok {
+		c.stats.Hits++
+	} else {
+		c.stats.Misses++
+	}
+	onEvict := c.onEvict
+	c.mutex.Unlock()
+
+	notifyEvicted(onEvict, evicted)
+	return value, ok
+}
+
+// must be called with the lock held
+func (c *TTL[K, V]) get(key K) (V, bool, []entry[K, V]) {
+	e, ok := c.entries[key]
+	if !ok {
+		return e.value, false, nil
+	}
+	if !c.now().Before(e.expiresAt) {
+		delete(c.entries, key)
+		c.stats.Evictions++
+		var zero V
+		return zero, false, []entry[K, V]{{key: key, value: e.value}}
+	}
+	return e.value, true, nil
+}
+
+// Adds an entry which expires after the cache's default ttl.
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// Adds an entry which expires after the given ttl.
+func (c *TTL[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries[key] = ttlEntry[V]{value: value, expiresAt: c.now().Add(ttl)}
+}
+
+// Removes the key, returning whether it was present and unexpired.
+func (c *TTL[K, V]) Delete(key K) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	e, ok := c.entries[key]
+	delete(c.entries, key)
+	return ok && c.now().Before(e.expiresAt)
+}
+
+// Removes all expired entries.
+func (c *TTL[K, V]) DeleteExpired() {
+	c.mutex.Lock()
+	now := c.now()
+	var evicted []entry[K, V]
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+			c.stats.Evictions++
+			evicted = append(evicted, entry[K, V]{key: key, value: e.value})
+		}
+	}
+	onEvict := c.onEvict
+	c.mutex.Unlock()
+
+	notifyEvicted(onEvict, evicted)
+}
+
+// Returns the number of unexpired entries.
+func (c *TTL[K, V]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+	count := 0
+	for _, e := range c.entries {
+		if now.Before(e.expiresAt) {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *TTL[K, V]) Stats() Stats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.stats
+}
+
+// Returns the cached value for the key, calling load to obtain and cache it
+// with the default ttl if missing or expired. Concurrent calls for the same
+// missing key share a single call to load. Errors from load are returned and
+// nothing is cached. If load panics, the panic propagates to its caller and any
+// waiting callers get an error.
+func (c *TTL[K, V]) GetOrLoad(key K, load func() (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	return c.loads.do(key, func() (V, error) {
+		// another goroutine may have loaded the value since our Get
+		c.mutex.Lock()
+		value, ok, evicted := c.get(key)
+		onEvict := c.onEvict
+		c.mutex.Unlock()
+		notifyEvicted(onEvict, evicted)
+		if ok {
+			return value, nil
+		}
+
+		value, err := load()
+		if err != nil {
+			return value, err
+		}
+		c.Set(key, value)
+		return value, nil
+	})
+}
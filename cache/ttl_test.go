@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestTTL(ttl time.Duration) (*TTL[string, int], *fakeClock) {
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewTTL[string, int](ttl)
+	c.SetClock(clock.Now)
+	return c, clock
+}
+
+func TestTTLExpiry(t *testing.T) {
+	c, clock := newTestTTL(time.Minute)
+	evictedKeys := []string{}
+	c.SetOnEvict(func(key string, value int) { evictedKeys = append(evictedKeys, key) })
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, 2*time.Minute)
+
+	clock.Advance(59 * time.Second)
+	if value, ok := c.Get("a"); !ok || value != 1 {
+		t.Errorf("Get(a) = %v, %v, expected %v, %v", value, ok, 1, true)
+	}
+
+	clock.Advance(time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected a to have expired")
+	}
+	if value, ok := c.Get("b"); !ok || value != 2 {
+		t.Errorf("Get(b) = %v, %v, expected %v, %v", value, ok, 2, true)
+	}
+	if c.Len() != 1 {
+		t.Errorf("Len() = %v, expected %v", c.Len(), 1)
+	}
+	testutils.ExpectSlice(t, []string{"a"}, evictedKeys)
+
+	expected := Stats{Hits: 2, Misses: 1, Evictions: 1}
+	if c.Stats() != expected {
+		t.Errorf("Stats() = %+v, expected %+v", c.Stats(), expected)
+	}
+}
+
+func TestTTLDeleteExpired(t *testing.T) {
+	c, clock := newTestTTL(time.Minute)
+	evictedKeys := []string{}
+	c.SetOnEvict(func(key string, value int) { evictedKeys = append(evictedKeys, key) })
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, 2*time.Minute)
+	clock.Advance(time.Minute)
+	c.DeleteExpired()
+
+	testutils.ExpectSlice(t, []string{"a"}, evictedKeys)
+	if c.Len() != 1 {
+		t.Errorf("Len() = %v, expected %v", c.Len(), 1)
+	}
+}
+
+func TestTTLDelete(t *testing.T) {
+	c, clock := newTestTTL(time.Minute)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	if !c.Delete("a") {
+		t.Errorf("Delete(a) = false, expected true")
+	}
+	clock.Advance(time.Minute)
+	if c.Delete("b") {
+		t.Errorf("Delete(b) = true, expected false for expired entry")
+	}
+}
+
+func TestTTLGetOrLoad(t *testing.T) {
+	c, clock := newTestTTL(time.Minute)
+	loadCount := 0
+	load := func() (int, error) {
+		loadCount++
+		return loadCount, nil
+	}
+
+	value, err := c.GetOrLoad("a", load)
+	testutils.ExpectNilError(t, err)
+	if value != 1 {
+		t.Errorf("GetOrLoad(a) = %v, expected %v", value, 1)
+	}
+
+	value, _ = c.GetOrLoad("a", load)
+	if value != 1 {
+		t.Errorf("GetOrLoad(a) = %v, expected %v", value, 1)
+	}
+
+	clock.Advance(time.Minute)
+	value, _ = c.GetOrLoad("a", load)
+	if value != 2 {
+		t.Errorf("GetOrLoad(a) = %v, expected %v after expiry", value, 2)
+	}
+
+	_, err = c.GetOrLoad("b", func() (int, error) { return 0, errors.New("failed") })
+	testutils.ExpectError(t, err, "failed")
+}
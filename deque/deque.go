@@ -0,0 +1,269 @@
+package deque
+
+import "fmt"
+
+// Deque is a double-ended queue backed by a growable ring buffer. Pushing and
+// popping at either end is amortised O(1), and random access is O(1). Unlike
+// slices.Shift, popping from the front doesn't leak the buffer's capacity:
+// the buffer shrinks again once it is mostly empty.
+//
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	// len(buf) is always zero or a power of two, so that indices can wrap with
+	// a mask rather than a modulo
+	buf  []T
+	head int
+	len  int
+}
+
+const minCapacity = 16
+
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// Returns a deque with room for at least the given number of elements before
+// it needs to grow
+func NewWithCapacity[T any](capacity int) *Deque[T] {
+	d := New[T]()
+	d.resize(capacity)
+	return d
+}
+
+func NewFromSlice[T any](slice []T) *Deque[T] {
+	d := NewWithCapacity[T](len(slice))
+	for _, value := range slice {
+		d.PushBack(value)
+	}
+	return d
+}
+
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.len == 0
+}
+
+func (d *Deque[T]) PushBack(value T) {
+	d.growIfFull()
+	d.buf[d.index(d.len)] = value
+	d.len++
+}
+
+func (d *Deque[T]) PushFront(value T) {
+	d.growIfFull()
+	d.head = d.index(-1)
+	d.buf[d.head] = value
+	d.len++
+}
+
+// Removes and returns the last element. Panics if the deque is empty.
+func (d *Deque[T]) PopBack() T {
+	if d.len == 0 {
+		panic("deque: PopBack called on empty deque")
+	}
+	d.len--
+	i := d.index(d.len)
+	value := d.buf[i]
+	// zeroing the slot so the buffer doesn't keep the value from being garbage
+	// collected
+	var zero T
+	d.buf[i] = zero
+	d.shrinkIfSparse()
+	return value
+}
+
+// Removes and returns the first element. Panics if the deque is empty.
+func (d *Deque[T]) PopFront() T {
+	if d.len == 0 {
+		panic("deque: PopFront called on empty deque")
+	}
+	value := d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.len--
+	d.shrinkIfSparse()
+	return value
+}
+
+// Like PopBack but returns false rather than panicking if the deque is empty
+func (d *Deque[T]) TryPopBack() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.PopBack(), true
+}
+
+// Like PopFront but returns false rather than panicking if the deque is empty
+func (d *Deque[T]) TryPopFront() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.PopFront(), true
+}
+
+// Returns the first element, or false if the deque is empty
+func (d *Deque[T]) Front() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// Returns the last element, or false if the deque is empty
+func (d *Deque[T]) Back() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(d.len-1)], true
+}
+
+// Returns the element at the given index, counting from the front. Panics if
+// the index is out of range.
+func (d *Deque[T]) Get(index int) T {
+	d.checkIndex(index)
+	return d.buf[d.index(index)]
+}
+
+// Panics if the index is out of range
+func (d *Deque[T]) Set(index int, value T) {
+	d.checkIndex(index)
+	d.buf[d.index(index)] = value
+}
+
+// Rotates the elements n steps towards the back, so that the last n elements
+// move to the front. A negative n rotates towards the front instead. Takes
+// O(min(n, Len()-n)) time.
+func (d *Deque[T]) Rotate(n int) {
+	if d.len <= 1 {
+		return
+	}
+	n %= d.len
+	if n < 0 {
+		n += d.len
+	}
+	if n == 0 {
+		return
+	}
+
+	if d.len == len(d.buf) {
+		// the buffer is full, so moving the head is enough
+		d.head = d.index(-n)
+		return
+	}
+
+	if n <= d.len/2 {
+		for i := 0; i < n; i++ {
+			d.head = d.index(-1)
+			d.buf[d.head] = d.buf[d.index(d.len)]
+			var zero T
+			d.buf[d.index(d.len)] = zero
+		}
+	} else {
+		for i := 0; i < d.len-n; i++ {
+			d.buf[d.index(d.len)] = d.buf[d.head]
+			var zero T
+			d.buf[d.head] = zero
+			d.head = d.index(1)
+		}
+	}
+}
+
+// Removes all elements, keeping the buffer
+func (d *Deque[T]) Clear() {
+	var zero T
+	for i := 0; i < d.len; i++ {
+		d.buf[d.index(i)] = zero
+	}
+	d.head = 0
+	d.len = 0
+}
+
+// Returns an iterator over the elements from front to back. The iterator calls
+// yield for each element until yield returns false.
+func (d *Deque[T]) FromFront() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.len; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the elements from back to front. The iterator calls
+// yield for each element until yield returns false.
+func (d *Deque[T]) FromBack() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for i := d.len - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Returns the elements from front to back in a new slice
+func (d *Deque[T]) ToSlice() []T {
+	result := make([]T, d.len)
+	d.copyTo(result)
+	return result
+}
+
+// Returns the buffer index of the element at the given offset from the head
+func (d *Deque[T]) index(offset int) int {
+	return (d.head + offset) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) checkIndex(index int) {
+	if index < 0 || index >= d.len {
+		panic(fmt.Sprintf("deque: index %d out of range with length %d", index, d.len))
+	}
+}
+
+func (d *Deque[T]) growIfFull() {
+	if d.len == len(d.buf) {
+		d.resize(d.len + 1)
+	}
+}
+
+func (d *Deque[T]) shrinkIfSparse() {
+	if len(d.buf) > minCapacity && d.len <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// Moves the elements into a new buffer with room for at least the given
+// number of elements
+func (d *Deque[T]) resize(capacity int) {
+	size := minCapacity
+	for size < capacity {
+		size *= 2
+	}
+	buf := make([]T, size)
+	d.copyTo(buf)
+	d.buf = buf
+	d.head = 0
+}
+
+// Copies the elements from front to back into dst, which must have room for
+// them
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.len == 0 {
+		return
+	}
+	end := d.head + d.len
+	if end <= len(d.buf) {
+		copy(dst, d.buf[d.head:end])
+		return
+	}
+	n := copy(dst, d.buf[d.head:])
+	copy(dst[n:], d.buf[:end-len(d.buf)])
+}
@@ -0,0 +1,248 @@
+package deque
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+	"github.com/jesseduffield/generics/slices"
+)
+
+func TestPushPop(t *testing.T) {
+	d := New[int]()
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	testutils.ExpectSlice(t, []int{1, 2, 3}, d.ToSlice())
+
+	if value := d.PopFront(); value != 1 {
+		t.Errorf("PopFront() = %v, expected %v", value, 1)
+	}
+	if value := d.PopBack(); value != 3 {
+		t.Errorf("PopBack() = %v, expected %v", value, 3)
+	}
+	front, ok := d.Front()
+	if !ok || front != 2 {
+		t.Errorf("Front() = %v, %v, expected %v, %v", front, ok, 2, true)
+	}
+	back, ok := d.Back()
+	if !ok || back != 2 {
+		t.Errorf("Back() = %v, %v, expected %v, %v", back, ok, 2, true)
+	}
+
+	d.PopBack()
+	if !d.IsEmpty() {
+		t.Errorf("IsEmpty() = false, expected true")
+	}
+	if _, ok := d.TryPopFront(); ok {
+		t.Errorf("TryPopFront() on empty deque returned true")
+	}
+	if _, ok := d.TryPopBack(); ok {
+		t.Errorf("TryPopBack() on empty deque returned true")
+	}
+	if _, ok := d.Front(); ok {
+		t.Errorf("Front() on empty deque returned true")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("Back() on empty deque returned true")
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var d Deque[string]
+	d.PushFront("b")
+	d.PushFront("a")
+	testutils.ExpectSlice(t, []string{"a", "b"}, d.ToSlice())
+}
+
+func TestGetSet(t *testing.T) {
+	d := New[int]()
+	// wrapping around the end of the buffer
+	for i := 0; i < 10; i++ {
+		d.PushFront(i)
+	}
+	d.Set(0, 100)
+	testutils.ExpectSlice(t, []int{100, 8, 7, 6, 5, 4, 3, 2, 1, 0}, d.ToSlice())
+	if d.Get(9) != 0 {
+		t.Errorf("Get(9) = %v, expected %v", d.Get(9), 0)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{1, []int{5, 1, 2, 3, 4}},
+		{4, []int{2, 3, 4, 5, 1}},
+		{-1, []int{2, 3, 4, 5, 1}},
+		{-4, []int{5, 1, 2, 3, 4}},
+		{7, []int{4, 5, 1, 2, 3}},
+		{-7, []int{3, 4, 5, 1, 2}},
+	}
+	for _, test := range tests {
+		d := NewFromSlice([]int{1, 2, 3, 4, 5})
+		d.Rotate(test.n)
+		testutils.ExpectSlice(t, test.expected, d.ToSlice())
+	}
+
+	// a full buffer takes a different path
+	full := NewFromSlice(make([]int, minCapacity))
+	for i := range full.ToSlice() {
+		full.Set(i, i)
+	}
+	full.Rotate(3)
+	if full.Get(0) != minCapacity-3 || full.Get(3) != 0 {
+		t.Errorf("Rotate(3) on full buffer gave %v", full.ToSlice())
+	}
+}
+
+func TestRotateEdgeCases(t *testing.T) {
+	// the front elements wrap round to the end of the buffer
+	wrapped := func() *Deque[int] {
+		d := NewFromSlice([]int{3, 4, 5})
+		d.PushFront(2)
+		d.PushFront(1)
+		return d
+	}
+	fullOf := func(n int) []int {
+		slice := make([]int, n)
+		for i := range slice {
+			slice[i] = i
+		}
+		return slice
+	}
+	full := fullOf(minCapacity)
+
+	tests := []struct {
+		name     string
+		deque    func() *Deque[int]
+		n        int
+		expected []int
+	}{
+		{"empty", func() *Deque[int] { return New[int]() }, 3, []int{}},
+		{"empty backwards", func() *Deque[int] { return New[int]() }, -3, []int{}},
+		{"single element", func() *Deque[int] { return NewFromSlice([]int{1}) }, 5, []int{1}},
+		{"by Len", func() *Deque[int] { return NewFromSlice([]int{1, 2, 3}) }, 3, []int{1, 2, 3}},
+		{"by a multiple of Len", func() *Deque[int] { return NewFromSlice([]int{1, 2, 3}) }, -9, []int{1, 2, 3}},
+		{"large", func() *Deque[int] { return NewFromSlice([]int{1, 2, 3}) }, 3001, []int{3, 1, 2}},
+		{"large backwards", func() *Deque[int] { return NewFromSlice([]int{1, 2, 3}) }, -3001, []int{2, 3, 1}},
+		{"wrapped", wrapped, 2, []int{4, 5, 1, 2, 3}},
+		{"wrapped backwards", wrapped, -2, []int{3, 4, 5, 1, 2}},
+		{"full backwards", func() *Deque[int] { return NewFromSlice(full) }, -3, append(fullOf(minCapacity)[3:], 0, 1, 2)},
+		{"full large", func() *Deque[int] { return NewFromSlice(full) }, minCapacity + 1, append([]int{minCapacity - 1}, full[:minCapacity-1]...)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := test.deque()
+			d.Rotate(test.n)
+			testutils.ExpectSlice(t, test.expected, d.ToSlice())
+
+			// pushing afterwards must still put values at the right ends
+			d.PushBack(100)
+			d.PushFront(-100)
+			testutils.ExpectSlice(t, append(append([]int{-100}, test.expected...), 100), d.ToSlice())
+		})
+	}
+}
+
+func TestIterators(t *testing.T) {
+	d := NewFromSlice([]int{1, 2, 3})
+
+	backwards := []int{}
+	d.FromBack()(func(value int) bool {
+		backwards = append(backwards, value)
+		return true
+	})
+	testutils.ExpectSlice(t, []int{3, 2, 1}, backwards)
+
+	forwards := []int{}
+	d.FromFront()(func(value int) bool {
+		forwards = append(forwards, value)
+		return value < 2
+	})
+	testutils.ExpectSlice(t, []int{1, 2}, forwards)
+}
+
+func TestShrinks(t *testing.T) {
+	d := New[int]()
+	for i := 0; i < 1000; i++ {
+		d.PushBack(i)
+	}
+	for i := 0; i < 995; i++ {
+		d.PopFront()
+	}
+	if len(d.buf) > minCapacity {
+		t.Errorf("buffer has length %v after popping, expected %v", len(d.buf), minCapacity)
+	}
+	testutils.ExpectSlice(t, []int{995, 996, 997, 998, 999}, d.ToSlice())
+
+	d.Clear()
+	testutils.ExpectSlice(t, []int{}, d.ToSlice())
+}
+
+func TestPanics(t *testing.T) {
+	d := NewFromSlice([]int{1})
+	panicTests := []func(){
+		func() { d.Get(1) },
+		func() { d.Get(-1) },
+		func() { d.Set(1, 0) },
+		func() { New[int]().PopFront() },
+		func() { New[int]().PopBack() },
+	}
+	for _, f := range panicTests {
+		func() {
+			defer testutils.ExpectPanic(t)
+			f()
+		}()
+	}
+}
+
+// Applies random operations to both a deque and a plain slice, checking that
+// they agree after every step.
+func TestAgainstSliceModel(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	d := New[int]()
+	model := []int{}
+
+	for i := 0; i < 5000; i++ {
+		value := random.Int()
+		switch random.Intn(6) {
+		case 0:
+			d.PushBack(value)
+			model = append(model, value)
+		case 1:
+			d.PushFront(value)
+			model = slices.Prepend(model, value)
+		case 2:
+			if len(model) > 0 {
+				d.PopBack()
+				model = model[:len(model)-1]
+			}
+		case 3:
+			if len(model) > 0 {
+				d.PopFront()
+				model = model[1:]
+			}
+		case 4:
+			if len(model) > 0 {
+				n := random.Intn(2*len(model)) - len(model)
+				d.Rotate(n)
+				split := ((len(model)-n)%len(model) + len(model)) % len(model)
+				model = append(append([]int{}, model[split:]...), model[:split]...)
+			}
+		case 5:
+			if len(model) > 0 {
+				index := random.Intn(len(model))
+				d.Set(index, value)
+				model[index] = value
+			}
+		}
+
+		if !slices.Equal(model, d.ToSlice()) {
+			t.Fatalf("step %v: deque %v does not match model %v", i, d.ToSlice(), model)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package deque
+
+import "fmt"
+
+// Ring is a fixed-capacity buffer which, once full, overwrites its oldest
+// element with each new one. Useful for keeping the last n entries of a log.
+//
+// Unlike Deque, the zero value has no capacity to fill, so create rings with
+// NewRing.
+type Ring[T any] struct {
+	buf []T
+	// index of the oldest element
+	head int
+	len  int
+}
+
+// Panics if capacity is not positive
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity <= 0 {
+		panic("deque: Ring capacity must be positive")
+	}
+	return &Ring[T]{buf: make([]T, capacity)}
+}
+
+func (r *Ring[T]) Len() int {
+	return r.len
+}
+
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+func (r *Ring[T]) IsEmpty() bool {
+	return r.len == 0
+}
+
+func (r *Ring[T]) IsFull() bool {
+	return r.len == len(r.buf)
+}
+
+// Adds the value as the newest element. If the ring is full, the oldest
+// element is overwritten and returned along with true.
+func (r *Ring[T]) Push(value T) (T, bool) {
+	if len(r.buf) == 0 {
+		panic("deque: Ring must be created with NewRing")
+	}
+	if r.len < len(r.buf) {
+		r.buf[r.index(r.len)] = value
+		r.len++
+		var zero T
+		return zero, false
+	}
+	overwritten := r.buf[r.head]
+	r.buf[r.head] = value
+	r.head = r.index(1)
+	return overwritten, true
+}
+
+// Removes and returns the oldest element, or returns false if the ring is
+// empty
+func (r *Ring[T]) PopOldest() (T, bool) {
+	var zero T
+	if r.len == 0 {
+		return zero, false
+	}
+	value := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = r.index(1)
+	r.len--
+	return value, true
+}
+
+// Returns the oldest element, or false if the ring is empty
+func (r *Ring[T]) Oldest() (T, bool) {
+	if r.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+// Returns the newest element, or false if the ring is empty
+func (r *Ring[T]) Newest() (T, bool) {
+	if r.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.index(r.len-1)], true
+}
+
+// Returns the element at the given index, where 0 is the oldest. Panics if the
+// index is out of range.
+func (r *Ring[T]) Get(index int) T {
+	if index < 0 || index >= r.len {
+		panic(fmt.Sprintf("deque: index %d out of range with length %d", index, r.len))
+	}
+	return r.buf[r.index(index)]
+}
+
+// Removes all elements, keeping the capacity
+func (r *Ring[T]) Clear() {
+	var zero T
+	for i := range r.buf {
+		r.buf[i] = zero
+	}
+	r.head = 0
+	r.len = 0
+}
+
+// Returns an iterator over the elements from oldest to newest. The iterator
+// calls yield for each element until yield returns false.
+func (r *Ring[T]) FromOldest() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.len; i++ {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the elements from newest to oldest. The iterator
+// calls yield for each element until yield returns false.
+func (r *Ring[T]) FromNewest() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for i := r.len - 1; i >= 0; i-- {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Returns the elements from oldest to newest in a new slice
+func (r *Ring[T]) ToSlice() []T {
+	result := make([]T, 0, r.len)
+	r.FromOldest()(func(value T) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
+// Returns the buffer index of the element at the given offset from the oldest
+func (r *Ring[T]) index(offset int) int {
+	return (r.head + offset) % len(r.buf)
+}
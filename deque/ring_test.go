@@ -0,0 +1,107 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestRingPush(t *testing.T) {
+	r := NewRing[int](3)
+	for i := 1; i <= 3; i++ {
+		if _, overwritten := r.Push(i); overwritten {
+			t.Errorf("Push(%v) overwrote an element before the ring was full", i)
+		}
+	}
+	if !r.IsFull() {
+		t.Errorf("IsFull() = false, expected true")
+	}
+
+	oldest, overwritten := r.Push(4)
+	if !overwritten || oldest != 1 {
+		t.Errorf("Push(4) = %v, %v, expected %v, %v", oldest, overwritten, 1, true)
+	}
+	r.Push(5)
+	testutils.ExpectSlice(t, []int{3, 4, 5}, r.ToSlice())
+	if r.Len() != 3 || r.Cap() != 3 {
+		t.Errorf("Len(), Cap() = %v, %v, expected %v, %v", r.Len(), r.Cap(), 3, 3)
+	}
+	if r.Get(0) != 3 || r.Get(2) != 5 {
+		t.Errorf("Get(0), Get(2) = %v, %v, expected %v, %v", r.Get(0), r.Get(2), 3, 5)
+	}
+
+	value, ok := r.Oldest()
+	if !ok || value != 3 {
+		t.Errorf("Oldest() = %v, %v, expected %v, %v", value, ok, 3, true)
+	}
+	value, ok = r.Newest()
+	if !ok || value != 5 {
+		t.Errorf("Newest() = %v, %v, expected %v, %v", value, ok, 5, true)
+	}
+}
+
+func TestRingPopOldest(t *testing.T) {
+	r := NewRing[string](2)
+	r.Push("a")
+	r.Push("b")
+	r.Push("c")
+
+	value, ok := r.PopOldest()
+	if !ok || value != "b" {
+		t.Errorf("PopOldest() = %v, %v, expected %v, %v", value, ok, "b", true)
+	}
+	r.Push("d")
+	testutils.ExpectSlice(t, []string{"c", "d"}, r.ToSlice())
+
+	r.Clear()
+	if !r.IsEmpty() {
+		t.Errorf("IsEmpty() = false, expected true")
+	}
+	if _, ok := r.PopOldest(); ok {
+		t.Errorf("PopOldest() on empty ring returned true")
+	}
+	if _, ok := r.Oldest(); ok {
+		t.Errorf("Oldest() on empty ring returned true")
+	}
+	if _, ok := r.Newest(); ok {
+		t.Errorf("Newest() on empty ring returned true")
+	}
+}
+
+func TestRingIterators(t *testing.T) {
+	r := NewRing[int](3)
+	for i := 1; i <= 5; i++ {
+		r.Push(i)
+	}
+
+	newest := []int{}
+	r.FromNewest()(func(value int) bool {
+		newest = append(newest, value)
+		return len(newest) < 2
+	})
+	testutils.ExpectSlice(t, []int{5, 4}, newest)
+
+	oldest := []int{}
+	r.FromOldest()(func(value int) bool {
+		oldest = append(oldest, value)
+		return true
+	})
+	testutils.ExpectSlice(t, []int{3, 4, 5}, oldest)
+}
+
+func TestRingPanics(t *testing.T) {
+	panicTests := []func(){
+		func() { NewRing[int](0) },
+		func() { NewRing[int](1).Get(0) },
+		func() {
+			var zero Ring[int]
+			zero.Push(1)
+		},
+	}
+	for _, f := range panicTests {
+		func() {
+			defer testutils.ExpectPanic(t)
+			f()
+		}()
+	}
+}
@@ -1,15 +1,15 @@
 module github.com/jesseduffield/generics
 
-go 1.18
+go 1.24
 
 require (
 	github.com/wk8/go-ordered-map/v2 v2.1.8
 	golang.org/x/exp v0.0.0-20220317015231-48e79f11773a
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/bahlo/generic-list-go v0.2.0 // indirect
 	github.com/buger/jsonparser v1.1.1 // indirect
 	github.com/mailru/easyjson v0.7.7 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
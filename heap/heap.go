@@ -0,0 +1,189 @@
+package heap
+
+// Heap is a binary heap: a priority queue which always pops the least element
+// according to its less function. Pass a greater-than function for a max-heap.
+//
+// Push returns a handle to the pushed element which can later be used to
+// remove the element, or to restore the heap order after changing its value.
+type Heap[T any] struct {
+	handles []*Handle[T]
+	less    func(a T, b T) bool
+}
+
+type Handle[T any] struct {
+	// After changing Value, call Heap.Fix to restore the heap order
+	Value T
+
+	index int
+	// the heap containing the element, or nil once it has been removed
+	heap *Heap[T]
+}
+
+func New[T any](less func(a T, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// Builds a heap from the slice in O(n) time. The slice itself is left
+// untouched.
+func NewFromSlice[T any](slice []T, less func(a T, b T) bool) *Heap[T] {
+	h := New(less)
+	h.handles = make([]*Handle[T], len(slice))
+	for i, value := range slice {
+		h.handles[i] = &Handle[T]{Value: value, index: i, heap: h}
+	}
+	h.heapify()
+	return h
+}
+
+func (h *Heap[T]) Len() int {
+	return len(h.handles)
+}
+
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.handles) == 0
+}
+
+// Adds the value in O(log n) time
+func (h *Heap[T]) Push(value T) *Handle[T] {
+	handle := &Handle[T]{Value: value, index: len(h.handles), heap: h}
+	h.handles = append(h.handles, handle)
+	h.up(handle.index)
+	return handle
+}
+
+// Removes and returns the least element in O(log n) time. Panics if the heap
+// is empty.
+func (h *Heap[T]) Pop() T {
+	if len(h.handles) == 0 {
+		panic("heap: Pop called on empty heap")
+	}
+	return h.remove(0).Value
+}
+
+// Like Pop but returns false rather than panicking if the heap is empty
+func (h *Heap[T]) TryPop() (T, bool) {
+	if len(h.handles) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.Pop(), true
+}
+
+// Returns the least element without removing it, or false if the heap is
+// empty
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.handles) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.handles[0].Value, true
+}
+
+// Restores the heap order after the handle's value has changed, in O(log n)
+// time. Returns false if the handle's element is not in the heap.
+func (h *Heap[T]) Fix(handle *Handle[T]) bool {
+	if handle.heap != h {
+		return false
+	}
+	if !h.down(handle.index) {
+		h.up(handle.index)
+	}
+	return true
+}
+
+// Removes the handle's element in O(log n) time. Returns false if the element
+// is not in the heap (e.g. because it has already been popped).
+func (h *Heap[T]) Remove(handle *Handle[T]) bool {
+	if handle.heap != h {
+		return false
+	}
+	h.remove(handle.index)
+	return true
+}
+
+// Moves all of other's elements into h in O(n + m) time, leaving other empty.
+// Handles to other's elements remain valid and now refer to h. Both heaps are
+// expected to have the same ordering.
+func (h *Heap[T]) Meld(other *Heap[T]) {
+	if other == h {
+		return
+	}
+	for _, handle := range other.handles {
+		handle.index = len(h.handles)
+		handle.heap = h
+		h.handles = append(h.handles, handle)
+	}
+	other.handles = nil
+	h.heapify()
+}
+
+// Returns the elements in no particular order
+func (h *Heap[T]) ToSlice() []T {
+	result := make([]T, len(h.handles))
+	for i, handle := range h.handles {
+		result[i] = handle.Value
+	}
+	return result
+}
+
+func (h *Heap[T]) remove(index int) *Handle[T] {
+	last := len(h.handles) - 1
+	if index != last {
+		h.swap(index, last)
+	}
+	handle := h.handles[last]
+	h.handles[last] = nil
+	h.handles = h.handles[:last]
+	if index != last && !h.down(index) {
+		h.up(index)
+	}
+	handle.heap = nil
+	return handle
+}
+
+func (h *Heap[T]) heapify() {
+	for i := len(h.handles)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+func (h *Heap[T]) lessAt(i int, j int) bool {
+	return h.less(h.handles[i].Value, h.handles[j].Value)
+}
+
+func (h *Heap[T]) swap(i int, j int) {
+	h.handles[i], h.handles[j] = h.handles[j], h.handles[i]
+	h.handles[i].index = i
+	h.handles[j].index = j
+}
+
+func (h *Heap[T]) up(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !h.lessAt(index, parent) {
+			break
+		}
+		h.swap(index, parent)
+		index = parent
+	}
+}
+
+// Returns whether the element moved
+func (h *Heap[T]) down(index int) bool {
+	start := index
+	for {
+		child := 2*index + 1
+		if child >= len(h.handles) {
+			break
+		}
+		if right := child + 1; right < len(h.handles) && h.lessAt(right, child) {
+			child = right
+		}
+		if !h.lessAt(child, index) {
+			break
+		}
+		h.swap(index, child)
+		index = child
+	}
+	return index > start
+}
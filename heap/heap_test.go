@@ -0,0 +1,189 @@
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+	"github.com/jesseduffield/generics/slices"
+)
+
+func lessInt(a int, b int) bool {
+	return a < b
+}
+
+func popAll[T any](h *Heap[T]) []T {
+	result := []T{}
+	for !h.IsEmpty() {
+		result = append(result, h.Pop())
+	}
+	return result
+}
+
+func TestPushPop(t *testing.T) {
+	h := New(lessInt)
+	for _, value := range []int{5, 3, 8, 1, 9, 2} {
+		h.Push(value)
+	}
+
+	value, ok := h.Peek()
+	if !ok || value != 1 {
+		t.Errorf("Peek() = %v, %v, expected %v, %v", value, ok, 1, true)
+	}
+	if h.Len() != 6 {
+		t.Errorf("Len() = %v, expected %v", h.Len(), 6)
+	}
+	testutils.ExpectSlice(t, []int{1, 2, 3, 5, 8, 9}, popAll(h))
+
+	if _, ok := h.Peek(); ok {
+		t.Errorf("Peek() on empty heap returned true")
+	}
+	if _, ok := h.TryPop(); ok {
+		t.Errorf("TryPop() on empty heap returned true")
+	}
+	func() {
+		defer testutils.ExpectPanic(t)
+		h.Pop()
+	}()
+}
+
+func TestMaxHeap(t *testing.T) {
+	h := NewFromSlice([]string{"b", "c", "a"}, func(a string, b string) bool { return a > b })
+	testutils.ExpectSlice(t, []string{"c", "b", "a"}, popAll(h))
+}
+
+func TestNewFromSlice(t *testing.T) {
+	slice := []int{4, 1, 3, 2}
+	h := NewFromSlice(slice, lessInt)
+	testutils.ExpectSlice(t, []int{1, 2, 3, 4}, popAll(h))
+	testutils.ExpectSlice(t, []int{4, 1, 3, 2}, slice)
+}
+
+func TestFixAndRemove(t *testing.T) {
+	h := New(lessInt)
+	handles := slices.Map([]int{10, 20, 30, 40}, h.Push)
+
+	handles[3].Value = 5
+	if !h.Fix(handles[3]) {
+		t.Errorf("Fix() = false, expected true")
+	}
+	handles[0].Value = 50
+	h.Fix(handles[0])
+	if !h.Remove(handles[1]) {
+		t.Errorf("Remove() = false, expected true")
+	}
+	if h.Remove(handles[1]) {
+		t.Errorf("Remove() of a removed element = true, expected false")
+	}
+	if h.Fix(handles[1]) {
+		t.Errorf("Fix() of a removed element = true, expected false")
+	}
+	if New(lessInt).Remove(handles[2]) {
+		t.Errorf("Remove() of another heap's element = true, expected false")
+	}
+
+	testutils.ExpectSlice(t, []int{5, 30, 50}, popAll(h))
+}
+
+func TestRemoveEdgeCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		remove   func(h *Heap[int], handles []*Handle[int]) *Handle[int]
+		expected []int
+	}{
+		{"only element", []int{1}, func(h *Heap[int], handles []*Handle[int]) *Handle[int] { return handles[0] }, []int{}},
+		{"root", []int{1, 2, 3, 4, 5}, func(h *Heap[int], handles []*Handle[int]) *Handle[int] { return h.handles[0] }, []int{2, 3, 4, 5}},
+		{"root of pair", []int{2, 1}, func(h *Heap[int], handles []*Handle[int]) *Handle[int] { return h.handles[0] }, []int{2}},
+		{"last", []int{1, 2, 3, 4, 5}, func(h *Heap[int], handles []*Handle[int]) *Handle[int] { return h.handles[h.Len()-1] }, []int{1, 2, 3, 4}},
+		{"last of pair", []int{1, 2}, func(h *Heap[int], handles []*Handle[int]) *Handle[int] { return h.handles[1] }, []int{1}},
+		// the last element replaces 11 and has to move up past 10
+		{"replacement moves up", []int{0, 10, 1, 11, 12, 2, 3}, func(h *Heap[int], handles []*Handle[int]) *Handle[int] { return handles[3] }, []int{0, 1, 2, 3, 10, 12}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := New(lessInt)
+			handles := slices.Map(test.values, h.Push)
+			handle := test.remove(h, handles)
+
+			if !h.Remove(handle) {
+				t.Errorf("Remove() = false, expected true")
+			}
+			if h.Remove(handle) {
+				t.Errorf("Remove() of a removed element = true, expected false")
+			}
+			for i, remaining := range h.handles {
+				if remaining.index != i {
+					t.Errorf("handle at %v has index %v", i, remaining.index)
+				}
+			}
+			testutils.ExpectSlice(t, test.expected, popAll(h))
+		})
+	}
+}
+
+func TestMeld(t *testing.T) {
+	h := NewFromSlice([]int{1, 4, 7}, lessInt)
+	other := New(lessInt)
+	handle := other.Push(5)
+	other.Push(2)
+
+	h.Meld(other)
+	if !other.IsEmpty() {
+		t.Errorf("expected melded heap to be empty")
+	}
+
+	// handles from the melded heap now belong to h
+	handle.Value = 0
+	if !h.Fix(handle) {
+		t.Errorf("Fix() = false, expected true")
+	}
+	testutils.ExpectSlice(t, []int{0, 1, 2, 4, 7}, popAll(h))
+}
+
+// Applies random operations to a heap while tracking its contents in a slice,
+// checking that the heap pops everything in sorted order at the end.
+func TestAgainstSliceModel(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	h := New(lessInt)
+	handles := []*Handle[int]{}
+
+	for i := 0; i < 3000; i++ {
+		switch random.Intn(5) {
+		case 0, 1:
+			handles = append(handles, h.Push(random.Intn(1000)))
+		case 2:
+			if len(handles) > 0 {
+				index := random.Intn(len(handles))
+				handles[index].Value = random.Intn(1000)
+				h.Fix(handles[index])
+			}
+		case 3:
+			if len(handles) > 0 {
+				index := random.Intn(len(handles))
+				h.Remove(handles[index])
+				handles = slices.Remove(handles, index)
+			}
+		case 4:
+			if len(handles) > 0 {
+				value := h.Pop()
+				index := slices.IndexFunc(handles, func(handle *Handle[int]) bool { return handle.heap == nil })
+				if handles[index].Value != value {
+					t.Fatalf("step %v: popped %v but removed handle has %v", i, value, handles[index].Value)
+				}
+				for _, handle := range handles {
+					if handle.Value < value {
+						t.Fatalf("step %v: popped %v but heap contains %v", i, value, handle.Value)
+					}
+				}
+				handles = slices.Remove(handles, index)
+			}
+		}
+	}
+
+	expected := slices.Map(handles, func(handle *Handle[int]) int { return handle.Value })
+	sort.Ints(expected)
+	testutils.ExpectSlice(t, expected, popAll(h))
+}
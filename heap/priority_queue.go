@@ -0,0 +1,97 @@
+package heap
+
+// PriorityQueue is a heap of keys, each with a priority which can be changed
+// while the key is in the queue, as needed by e.g. Dijkstra's algorithm. The
+// key with the least priority according to the less function is popped first.
+type PriorityQueue[K comparable, P any] struct {
+	heap    *Heap[entry[K, P]]
+	handles map[K]*Handle[entry[K, P]]
+}
+
+type entry[K comparable, P any] struct {
+	key      K
+	priority P
+}
+
+func NewPriorityQueue[K comparable, P any](less func(a P, b P) bool) *PriorityQueue[K, P] {
+	return &PriorityQueue[K, P]{
+		heap: New(func(a entry[K, P], b entry[K, P]) bool {
+			return less(a.priority, b.priority)
+		}),
+		handles: map[K]*Handle[entry[K, P]]{},
+	}
+}
+
+func (q *PriorityQueue[K, P]) Len() int {
+	return q.heap.Len()
+}
+
+func (q *PriorityQueue[K, P]) IsEmpty() bool {
+	return q.heap.IsEmpty()
+}
+
+// Sets the key's priority, adding the key if it isn't already in the queue.
+// Takes O(log n) time.
+func (q *PriorityQueue[K, P]) Update(key K, priority P) {
+	if handle, ok := q.handles[key]; ok {
+		handle.Value.priority = priority
+		q.heap.Fix(handle)
+		return
+	}
+	q.handles[key] = q.heap.Push(entry[K, P]{key: key, priority: priority})
+}
+
+// Removes and returns the key with the least priority. Panics if the queue is
+// empty.
+func (q *PriorityQueue[K, P]) Pop() (K, P) {
+	if q.IsEmpty() {
+		panic("heap: Pop called on empty priority queue")
+	}
+	e := q.heap.Pop()
+	delete(q.handles, e.key)
+	return e.key, e.priority
+}
+
+// Like Pop but returns false rather than panicking if the queue is empty
+func (q *PriorityQueue[K, P]) TryPop() (K, P, bool) {
+	if q.IsEmpty() {
+		var key K
+		var priority P
+		return key, priority, false
+	}
+	key, priority := q.Pop()
+	return key, priority, true
+}
+
+// Returns the key with the least priority without removing it, or false if
+// the queue is empty
+func (q *PriorityQueue[K, P]) Peek() (K, P, bool) {
+	e, ok := q.heap.Peek()
+	return e.key, e.priority, ok
+}
+
+// Returns the key's priority, or false if the key is not in the queue
+func (q *PriorityQueue[K, P]) Priority(key K) (P, bool) {
+	handle, ok := q.handles[key]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+	return handle.Value.priority, true
+}
+
+func (q *PriorityQueue[K, P]) Contains(key K) bool {
+	_, ok := q.handles[key]
+	return ok
+}
+
+// Removes the key, returning whether it was present
+func (q *PriorityQueue[K, P]) Remove(key K) bool {
+	handle, ok := q.handles[key]
+	if !ok {
+		return false
+	}
+	q.heap.Remove(handle)
+	delete(q.handles, key)
+	return true
+}
@@ -0,0 +1,76 @@
+package heap
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	q := NewPriorityQueue[string](lessInt)
+	q.Update("a", 5)
+	q.Update("b", 3)
+	q.Update("c", 8)
+	q.Update("c", 1)
+
+	if q.Len() != 3 || !q.Contains("c") || q.Contains("d") {
+		t.Errorf("unexpected contents after updates")
+	}
+	priority, ok := q.Priority("c")
+	if !ok || priority != 1 {
+		t.Errorf("Priority(c) = %v, %v, expected %v, %v", priority, ok, 1, true)
+	}
+	key, priority, ok := q.Peek()
+	if !ok || key != "c" || priority != 1 {
+		t.Errorf("Peek() = %v, %v, %v, expected %v, %v, %v", key, priority, ok, "c", 1, true)
+	}
+
+	if !q.Remove("b") || q.Remove("b") {
+		t.Errorf("expected Remove(b) to succeed exactly once")
+	}
+
+	key, priority = q.Pop()
+	if key != "c" || priority != 1 {
+		t.Errorf("Pop() = %v, %v, expected %v, %v", key, priority, "c", 1)
+	}
+	key, priority, ok = q.TryPop()
+	if !ok || key != "a" || priority != 5 {
+		t.Errorf("TryPop() = %v, %v, %v, expected %v, %v, %v", key, priority, ok, "a", 5, true)
+	}
+
+	if _, _, ok := q.TryPop(); ok {
+		t.Errorf("TryPop() on empty queue returned true")
+	}
+	if _, ok := q.Priority("a"); ok {
+		t.Errorf("Priority(a) of a popped key returned true")
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	edges := map[string]map[string]int{
+		"a": {"b": 7, "c": 9, "f": 14},
+		"b": {"a": 7, "c": 10, "d": 15},
+		"c": {"a": 9, "b": 10, "d": 11, "f": 2},
+		"d": {"b": 15, "c": 11, "e": 6},
+		"e": {"d": 6, "f": 9},
+		"f": {"a": 14, "c": 2, "e": 9},
+	}
+
+	distances := map[string]int{}
+	q := NewPriorityQueue[string](lessInt)
+	q.Update("a", 0)
+	for !q.IsEmpty() {
+		node, distance := q.Pop()
+		distances[node] = distance
+		for neighbour, weight := range edges[node] {
+			if _, done := distances[neighbour]; done {
+				continue
+			}
+			if current, ok := q.Priority(neighbour); !ok || distance+weight < current {
+				q.Update(neighbour, distance+weight)
+			}
+		}
+	}
+
+	testutils.ExpectMap(t, map[string]int{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11}, distances)
+}
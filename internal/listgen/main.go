@@ -0,0 +1,704 @@
+// listgen generates the parts of the list package which simply delegate to the
+// slices package, so that List stays in sync with slices as functions are
+// added. It is run via go generate from the list package.
+//
+// For each exported function in slices whose first parameter is a slice of a
+// type parameter:
+//   - if the function has no other type parameters, it becomes a method on
+//     List, ComparableList or OrderedList depending on the element constraint
+//   - otherwise (e.g. Map, which changes the element type, or Sum, whose
+//     element constraint matches no list type), it becomes a standalone
+//     function taking a *List as its first argument
+//
+// Hand-written methods and functions in the list package take precedence, so
+// the generator skips any name that already exists there.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const outputFileName = "slices_gen.go"
+
+func main() {
+	slicesDir := flag.String("slices", "../slices", "directory of the slices package")
+	listDir := flag.String("list", ".", "directory of the list package")
+	flag.Parse()
+
+	output, err := generate(*slicesDir, *listDir, inPlace)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(*listDir, outputFileName), output, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// The list types and their constructors, keyed by the element constraint
+var receivers = map[string]receiver{
+	"any":                 {typeName: "List", constructor: "NewFromSlice"},
+	"comparable":          {typeName: "ComparableList", constructor: "NewComparableFromSlice"},
+	"constraints.Ordered": {typeName: "OrderedList", constructor: "NewOrderedFromSlice"},
+}
+
+// slices functions which already have a hand-written counterpart under a
+// different name
+var skipped = map[string]bool{
+	"PopOK":   true, // List.TryPop
+	"ShiftOK": true, // List.TryShift
+}
+
+// Each list type embeds the previous one, so it has access to its methods
+var receiverHierarchy = []string{"List", "ComparableList", "OrderedList"}
+
+type receiver struct {
+	typeName    string
+	constructor string
+}
+
+// Says, for each slices function which takes a slice and returns nothing or a
+// slice of the same element type, whether it modifies the input slice. Methods
+// for those that do prepare the list for mutation (see list.NewCopyOnWrite)
+// and assign the result back to the list rather than returning a new list.
+// Generation fails for any such function missing from here, because guessing
+// wrong would let copy-on-write lists share a slice.
+var inPlace = map[string]bool{
+	"AppendSeq":                 true,
+	"ApplyEdits":                false,
+	"BottomK":                   false,
+	"Clip":                      true,
+	"Clone":                     false,
+	"Compact":                   true,
+	"CompactFunc":               true,
+	"Concat":                    false,
+	"Delete":                    true,
+	"DeleteFunc":                true,
+	"Difference":                false,
+	"DifferenceBy":              false,
+	"DifferenceSorted":          false,
+	"Filter":                    false,
+	"FilterInPlace":             true,
+	"FilterWithIndex":           false,
+	"ForEach":                   false,
+	"ForEachWithIndex":          false,
+	"Grow":                      true,
+	"Insert":                    true,
+	"Intersect":                 false,
+	"IntersectBy":               false,
+	"IntersectSorted":           false,
+	"LongestCommonSubsequence":  false,
+	"MapInPlace":                true,
+	"Move":                      true,
+	"NthElement":                true,
+	"PartialSort":               true,
+	"Partition":                 false,
+	"Pop":                       true,
+	"PopOK":                     true,
+	"Prepend":                   true,
+	"Remove":                    true,
+	"Repeat":                    false,
+	"Replace":                   true,
+	"Reverse":                   false,
+	"ReverseInPlace":            true,
+	"Shift":                     true,
+	"ShiftOK":                   true,
+	"Sort":                      true,
+	"SortBy":                    true,
+	"SortFunc":                  true,
+	"SortLessFunc":              true,
+	"SortStableBy":              true,
+	"SortStableFunc":            true,
+	"SortStableLessFunc":        true,
+	"Swap":                      true,
+	"SymmetricDifference":       false,
+	"SymmetricDifferenceBy":     false,
+	"SymmetricDifferenceSorted": false,
+	"TopK":                      false,
+	"TryFilter":                 false,
+	"TryFilterWithIndex":        false,
+	"Union":                     false,
+	"UnionBy":                   false,
+	"UnionSorted":               false,
+}
+
+type param struct {
+	name string
+	typ  ast.Expr
+}
+
+type function struct {
+	decl       *ast.FuncDecl
+	name       string
+	typeParams []param
+	params     []param
+	results    []ast.Expr
+	mutative   bool
+	// type parameter names of the element type and, if the function uses the
+	// `S ~[]E` pattern, of the slice type
+	elem      string
+	sliceType string
+	// types declared in the slices package, which need qualifying with the
+	// package name when used from the list package
+	packageTypes map[string]bool
+}
+
+func generate(slicesDir string, listDir string, inPlace map[string]bool) ([]byte, error) {
+	functions, err := parseSlicesFunctions(slicesDir)
+	if err != nil {
+		return nil, err
+	}
+	for i, f := range functions {
+		if !f.mayModifyInput() {
+			continue
+		}
+		mutative, ok := inPlace[f.name]
+		if !ok {
+			return nil, fmt.Errorf("slices.%s may modify its input slice: add it to inPlace in internal/listgen", f.name)
+		}
+		functions[i].mutative = mutative
+	}
+
+	existingMethods, existingFunctions, err := parseListNames(listDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/listgen; DO NOT EDIT.\n\n")
+	buf.WriteString("package list\n\n")
+
+	var body bytes.Buffer
+	for _, f := range functions {
+		if skipped[f.name] {
+			continue
+		}
+		if !f.isOtherTypeParamFree() {
+			if !existingFunctions[f.name] {
+				writeFunction(&body, f)
+			}
+			continue
+		}
+
+		r, ok := receivers[f.constraintOf(f.elem)]
+		if !ok {
+			// no list type has this constraint (e.g. numeric functions), so a
+			// standalone function is the only option
+			if !existingFunctions[f.name] {
+				writeFunction(&body, f)
+			}
+			continue
+		}
+		if hasMethod(existingMethods, r.typeName, f.name) {
+			continue
+		}
+		writeMethod(&body, f, r)
+	}
+
+	standardImports := []string{}
+	if strings.Contains(body.String(), "cmp.") {
+		standardImports = append(standardImports, `"cmp"`)
+	}
+	if strings.Contains(body.String(), "iter.") {
+		standardImports = append(standardImports, `"iter"`)
+	}
+	imports := []string{`"github.com/jesseduffield/generics/slices"`}
+	if strings.Contains(body.String(), "constraints.") {
+		imports = append(imports, `"golang.org/x/exp/constraints"`)
+	}
+	if len(standardImports) > 0 {
+		imports = append(append(standardImports, ""), imports...)
+	}
+	if body.Len() > 0 {
+		fmt.Fprintf(&buf, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+func hasMethod(existingMethods map[string]map[string]bool, typeName string, name string) bool {
+	for _, candidate := range receiverHierarchy {
+		if existingMethods[candidate][name] {
+			return true
+		}
+		if candidate == typeName {
+			return false
+		}
+	}
+	return false
+}
+
+func parseSlicesFunctions(dir string) ([]function, error) {
+	files, err := parseDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	packageTypes := map[string]bool{}
+	for _, parsed := range files {
+		for _, decl := range parsed.file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				packageTypes[spec.(*ast.TypeSpec).Name.Name] = true
+			}
+		}
+	}
+
+	functions := []function{}
+	for _, parsed := range files {
+		for _, decl := range parsed.file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil || !funcDecl.Name.IsExported() {
+				continue
+			}
+			if f, ok := newFunction(funcDecl); ok {
+				f.packageTypes = packageTypes
+				functions = append(functions, f)
+			}
+		}
+	}
+	return functions, nil
+}
+
+// Returns the names of the methods on each list type, and of the package-level
+// functions, which are defined by hand in the list package.
+func parseListNames(dir string) (map[string]map[string]bool, map[string]bool, error) {
+	files, err := parseDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	methods := map[string]map[string]bool{}
+	functions := map[string]bool{}
+	for _, parsed := range files {
+		if filepath.Base(parsed.path) == outputFileName {
+			continue
+		}
+		for _, decl := range parsed.file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if funcDecl.Recv == nil {
+				functions[funcDecl.Name.Name] = true
+				continue
+			}
+			typeName := receiverTypeName(funcDecl.Recv.List[0].Type)
+			if methods[typeName] == nil {
+				methods[typeName] = map[string]bool{}
+			}
+			methods[typeName][funcDecl.Name.Name] = true
+		}
+	}
+	return methods, functions, nil
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(e.X)
+	case *ast.Ident:
+		return e.Name
+	default:
+		return ""
+	}
+}
+
+type parsedFile struct {
+	path string
+	file *ast.File
+}
+
+// Returns the non-test files in the directory, sorted by path.
+func parseDir(dir string) ([]parsedFile, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	files := []parsedFile{}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, parsedFile{path: path, file: file})
+	}
+	return files, nil
+}
+
+// Returns false if the function's first parameter is not a slice of a type
+// parameter, in which case we can't generate anything for it.
+func newFunction(decl *ast.FuncDecl) (function, bool) {
+	f := function{
+		decl:       decl,
+		name:       decl.Name.Name,
+		typeParams: flattenFields(decl.Type.TypeParams),
+		params:     flattenFields(decl.Type.Params),
+	}
+	for _, result := range flattenFields(decl.Type.Results) {
+		f.results = append(f.results, result.typ)
+	}
+
+	if len(f.params) == 0 {
+		return f, false
+	}
+	elem, ok := f.sliceElem(f.params[0].typ)
+	if !ok {
+		return f, false
+	}
+	f.elem = elem
+	if ident, ok := f.params[0].typ.(*ast.Ident); ok {
+		f.sliceType = ident.Name
+	}
+	return f, true
+}
+
+// Returns true if the function returns nothing or a slice of the same element
+// type as its input, i.e. if its signature alone doesn't rule out modifying the
+// input slice.
+func (f function) mayModifyInput() bool {
+	if len(f.results) == 0 {
+		return true
+	}
+	for _, result := range f.results {
+		if elem, ok := f.sliceElem(result); ok && elem == f.elem {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenFields(fields *ast.FieldList) []param {
+	result := []param{}
+	if fields == nil {
+		return result
+	}
+	for _, field := range fields.List {
+		if len(field.Names) == 0 {
+			result = append(result, param{typ: field.Type})
+		}
+		for _, name := range field.Names {
+			result = append(result, param{name: name.Name, typ: field.Type})
+		}
+	}
+	return result
+}
+
+// If the expression is a slice of a type parameter (either []E, or S where S
+// is constrained by ~[]E), returns the name of the element type parameter.
+func (f function) sliceElem(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.ArrayType:
+		if ident, ok := e.Elt.(*ast.Ident); ok && e.Len == nil && f.isTypeParam(ident.Name) {
+			return ident.Name, true
+		}
+	case *ast.Ident:
+		for _, typeParam := range f.typeParams {
+			if typeParam.name != e.Name {
+				continue
+			}
+			if unary, ok := typeParam.typ.(*ast.UnaryExpr); ok && unary.Op == token.TILDE {
+				return f.sliceElem(unary.X)
+			}
+		}
+	}
+	return "", false
+}
+
+func (f function) isTypeParam(name string) bool {
+	for _, typeParam := range f.typeParams {
+		if typeParam.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (f function) constraintOf(name string) string {
+	for _, typeParam := range f.typeParams {
+		if typeParam.name == name {
+			return render(typeParam.typ)
+		}
+	}
+	return ""
+}
+
+// Reports whether the element (and slice) type parameters are the only ones,
+// meaning the function can become a method.
+func (f function) isOtherTypeParamFree() bool {
+	for _, typeParam := range f.typeParams {
+		if typeParam.name != f.elem && typeParam.name != f.sliceType {
+			return false
+		}
+	}
+	return true
+}
+
+// Renders a type, substituting type parameters as needed for a method on a
+// list type whose type parameter is named T.
+func (f function) renderForMethod(expr ast.Expr) string {
+	replacements := f.qualifiers()
+	replacements[f.elem] = "T"
+	if f.sliceType != "" {
+		replacements[f.sliceType] = "[]T"
+	}
+	return replaceIdents(render(expr), replacements)
+}
+
+// Renders a type for use in a standalone function in the list package, where
+// slice type parameters are replaced by plain slices.
+func (f function) renderForFunction(expr ast.Expr) string {
+	replacements := f.qualifiers()
+	for _, typeParam := range f.typeParams {
+		if elem, ok := f.sliceElem(&ast.Ident{Name: typeParam.name}); ok {
+			replacements[typeParam.name] = "[]" + elem
+		}
+	}
+	return replaceIdents(render(expr), replacements)
+}
+
+// Returns replacements qualifying the slices package's own types (e.g. Edit
+// becomes slices.Edit).
+func (f function) qualifiers() map[string]string {
+	result := map[string]string{}
+	for name := range f.packageTypes {
+		result[name] = "slices." + name
+	}
+	return result
+}
+
+// Replaces identifiers in rendered code, ignoring those qualified by a package
+// name (e.g. the Ordered in constraints.Ordered).
+func replaceIdents(code string, replacements map[string]string) string {
+	var buf strings.Builder
+	for i := 0; i < len(code); {
+		if !isIdentChar(code[i]) {
+			buf.WriteByte(code[i])
+			i++
+			continue
+		}
+		start := i
+		for i < len(code) && isIdentChar(code[i]) {
+			i++
+		}
+		ident := code[start:i]
+		if replacement, ok := replacements[ident]; ok && !isQualified(code, start) {
+			ident = replacement
+		}
+		buf.WriteString(ident)
+	}
+	return buf.String()
+}
+
+// Reports whether the identifier starting at the given index follows a package
+// name, as opposed to e.g. the ellipsis of a variadic parameter.
+func isQualified(code string, start int) bool {
+	return start > 0 && code[start-1] == '.' && !strings.HasSuffix(code[:start], "...")
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func render(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), expr); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+// Writes a method on the given list type which delegates to the function.
+func writeMethod(buf *bytes.Buffer, f function, r receiver) {
+	args := []string{"l.slice"}
+	params := []string{}
+	for i, p := range f.params[1:] {
+		name := paramName(p, i+1)
+		if _, ok := f.sliceElem(p.typ); ok {
+			params = append(params, fmt.Sprintf("%s *List[T]", name))
+			args = append(args, name+".slice")
+			continue
+		}
+		params = append(params, fmt.Sprintf("%s %s", name, f.renderForMethod(p.typ)))
+		args = append(args, argument(p, name))
+	}
+
+	resultTypes := []string{}
+	resultValues := []string{}
+	assignments := []string{}
+	wrapped := map[int]bool{}
+	for i, result := range f.results {
+		value := fmt.Sprintf("r%d", i)
+		if _, ok := f.sliceElem(result); ok {
+			if f.mutative {
+				assignments = append(assignments, "l.slice = "+value)
+				continue
+			}
+			wrapped[len(resultValues)] = true
+			resultTypes = append(resultTypes, fmt.Sprintf("*%s[T]", r.typeName))
+			resultValues = append(resultValues, fmt.Sprintf("%s(%s)", r.constructor, value))
+			continue
+		}
+		resultTypes = append(resultTypes, f.renderForMethod(result))
+		resultValues = append(resultValues, value)
+	}
+
+	fmt.Fprintf(buf, "// See slices.%s\n", f.name)
+	fmt.Fprintf(buf, "func (l *%s[T]) %s(%s) %s {\n", r.typeName, f.name, strings.Join(params, ", "), renderResults(resultTypes))
+	if f.mutative {
+		// see list.NewCopyOnWrite
+		buf.WriteString("l.prepareForMutation()\n")
+	}
+	writeBody(buf, f, args, assignments, resultValues, wrapped)
+	buf.WriteString("}\n\n")
+}
+
+// Writes a standalone function taking a *List in place of the function's
+// first argument.
+func writeFunction(buf *bytes.Buffer, f function) {
+	typeParams := []string{}
+	for _, p := range f.typeParams {
+		if _, ok := f.sliceElem(&ast.Ident{Name: p.name}); ok {
+			// slice type parameters are replaced by lists
+			continue
+		}
+		typeParams = append(typeParams, fmt.Sprintf("%s %s", p.name, f.renderForFunction(p.typ)))
+	}
+
+	args := []string{}
+	params := []string{}
+	for i, p := range f.params {
+		name := paramName(p, i)
+		if i == 0 {
+			name = "l"
+		}
+		if elem, ok := f.sliceElem(p.typ); ok {
+			params = append(params, fmt.Sprintf("%s *List[%s]", name, elem))
+			args = append(args, name+".slice")
+			continue
+		}
+		params = append(params, fmt.Sprintf("%s %s", name, f.renderForFunction(p.typ)))
+		args = append(args, argument(p, name))
+	}
+
+	resultTypes := []string{}
+	resultValues := []string{}
+	assignments := []string{}
+	wrapped := map[int]bool{}
+	for i, result := range f.results {
+		value := fmt.Sprintf("r%d", i)
+		if elem, ok := f.sliceElem(result); ok {
+			if f.mutative && elem == f.elem {
+				assignments = append(assignments, "l.slice = "+value)
+				continue
+			}
+			wrapped[len(resultValues)] = true
+			resultTypes = append(resultTypes, fmt.Sprintf("*List[%s]", elem))
+			resultValues = append(resultValues, fmt.Sprintf("NewFromSlice(%s)", value))
+			continue
+		}
+		resultTypes = append(resultTypes, f.renderForFunction(result))
+		resultValues = append(resultValues, value)
+	}
+
+	fmt.Fprintf(buf, "// See slices.%s\n", f.name)
+	fmt.Fprintf(buf, "func %s[%s](%s) %s {\n", f.name, strings.Join(typeParams, ", "), strings.Join(params, ", "), renderResults(resultTypes))
+	if f.mutative {
+		// see list.NewCopyOnWrite
+		buf.WriteString("l.prepareForMutation()\n")
+	}
+	writeBody(buf, f, args, assignments, resultValues, wrapped)
+	buf.WriteString("}\n\n")
+}
+
+// Writes the call to the slices function followed by any assignments back to
+// the list and the return statement. If the function returns an error, the
+// wrapped lists are nil when the error is non-nil.
+func writeBody(buf *bytes.Buffer, f function, args []string, assignments []string, resultValues []string, wrapped map[int]bool) {
+	call := fmt.Sprintf("slices.%s(%s)", f.name, strings.Join(args, ", "))
+	if len(f.results) == 0 {
+		fmt.Fprintf(buf, "%s\n", call)
+		return
+	}
+
+	if len(assignments) == 0 && len(wrapped) == 0 {
+		fmt.Fprintf(buf, "return %s\n", call)
+		return
+	}
+
+	values := []string{}
+	for i := range f.results {
+		values = append(values, fmt.Sprintf("r%d", i))
+	}
+	fmt.Fprintf(buf, "%s := %s\n", strings.Join(values, ", "), call)
+	for _, assignment := range assignments {
+		fmt.Fprintf(buf, "%s\n", assignment)
+	}
+
+	if len(wrapped) > 0 && render(f.results[len(f.results)-1]) == "error" {
+		errorResults := []string{}
+		for i, value := range resultValues {
+			if wrapped[i] {
+				value = "nil"
+			}
+			errorResults = append(errorResults, value)
+		}
+		fmt.Fprintf(buf, "if %s != nil {\nreturn %s\n}\n", values[len(values)-1], strings.Join(errorResults, ", "))
+		resultValues[len(resultValues)-1] = "nil"
+	}
+
+	if len(resultValues) > 0 {
+		fmt.Fprintf(buf, "return %s\n", strings.Join(resultValues, ", "))
+	}
+}
+
+func renderResults(resultTypes []string) string {
+	switch len(resultTypes) {
+	case 0:
+		return ""
+	case 1:
+		return resultTypes[0]
+	default:
+		return "(" + strings.Join(resultTypes, ", ") + ")"
+	}
+}
+
+func paramName(p param, index int) string {
+	if p.name == "" || p.name == "_" {
+		return fmt.Sprintf("p%d", index)
+	}
+	return p.name
+}
+
+func argument(p param, name string) string {
+	if _, ok := p.typ.(*ast.Ellipsis); ok {
+		return name + "..."
+	}
+	return name
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+// The in-place classification of the functions in testdata/slices
+var testdataInPlace = map[string]bool{
+	"Each":               false,
+	"Existing":           false,
+	"ExistingStandalone": false,
+	"Keep":               false,
+	"Pop":                true,
+	"Push":               true,
+	"Shuffle":            true,
+	"ShuffleBy":          true,
+	"Spliced":            false,
+	"Trim":               true,
+	"TryKeep":            false,
+	"Uniq":               false,
+}
+
+func TestGenerate(t *testing.T) {
+	actual, err := generate("testdata/slices", "testdata/list", testdataInPlace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile("testdata/slices_gen.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(actual) != string(expected) {
+		t.Errorf("Generated code does not match testdata/slices_gen.golden. Got:\n%s", actual)
+	}
+}
+
+func TestGenerateRequiresInPlaceClassification(t *testing.T) {
+	classified := map[string]bool{}
+	for name, mutative := range testdataInPlace {
+		classified[name] = mutative
+	}
+	delete(classified, "Shuffle")
+
+	_, err := generate("testdata/slices", "testdata/list", classified)
+	testutils.ExpectError(t, err, "slices.Shuffle may modify its input slice: add it to inPlace in internal/listgen")
+}
+
+// Ensures that nobody forgets to run go generate after changing the slices or
+// list packages.
+func TestGeneratedListIsUpToDate(t *testing.T) {
+	listDir := filepath.Join("..", "..", "list")
+	expected, err := generate(filepath.Join("..", "..", "slices"), listDir, inPlace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := os.ReadFile(filepath.Join(listDir, outputFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(actual) != string(expected) {
+		t.Errorf("list/%s is out of date: run `go generate ./list`", outputFileName)
+	}
+}
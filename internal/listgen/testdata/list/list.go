@@ -0,0 +1,9 @@
+package list
+
+type List[T any] struct {
+	slice []T
+}
+
+func (l *List[T]) Existing() {}
+
+func ExistingStandalone() {}
@@ -0,0 +1,97 @@
+package slices
+
+import (
+	"cmp"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+func Keep[T any](slice []T, test func(T) bool) []T {
+	return nil
+}
+
+// Intended usage is to reassign the result to the input slice.
+func Trim[S ~[]E, E any](s S, n int) S {
+	return s
+}
+
+// Intended usage is to reassign the result to the input slice.
+func Push[T any](slice []T, values ...T) []T {
+	return slice
+}
+
+// Intended usage is to reassign the result to the input slice.
+func Pop[T any](slice []T) (T, []T) {
+	return slice[0], slice
+}
+
+// Modifies the contents of the slice in place.
+func Shuffle[T any](slice []T, seed int) {}
+
+// Modifies the contents of the slice in place.
+func ShuffleBy[T any, K cmp.Ordered](slice []T, f func(T) K) {}
+
+func TryKeep[T any](slice []T, test func(T) (bool, error)) ([]T, error) {
+	return nil, nil
+}
+
+func Each[T any](slice []T, f func(T)) {}
+
+func Items[S ~[]E, E any](s S) iter.Seq2[int, E] {
+	return nil
+}
+
+func Spliced[S ~[]E, E any](s S, i int, v ...E) S {
+	return s
+}
+
+func Matches[S1 ~[]E1, S2 ~[]E2, E1, E2 any](s1 S1, s2 S2, eq func(E1, E2) bool) bool {
+	return false
+}
+
+func Same[T any](slice []T, other []T) bool {
+	return false
+}
+
+func Uniq[E comparable](s []E) []E {
+	return s
+}
+
+func Largest[E constraints.Ordered](s []E) E {
+	return s[0]
+}
+
+func Total[E constraints.Integer | constraints.Float](s []E) E {
+	return s[0]
+}
+
+type Pair struct {
+	Index int
+}
+
+func Pairs[T any](slice []T, others []Pair) []Pair {
+	return others
+}
+
+func PairsWith[T any, V any](slice []T, f func(T) V) []Pair {
+	return nil
+}
+
+func Convert[T any, V any](slice []T, f func(T) V) []V {
+	return nil
+}
+
+func TryConvert[T any, V any](slice []T, f func(T) (V, error)) ([]V, error) {
+	return nil, nil
+}
+
+func Existing[T any](slice []T) {}
+
+func ExistingStandalone[T any, V any](slice []T, f func(T) V) {}
+
+func Nested[T any](slice [][]T) []T {
+	return nil
+}
+
+func unexported[T any](slice []T) {}
@@ -0,0 +1,41 @@
+package testutils
+
+import (
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"testing"
+)
+
+// Compiles only if ours has exactly the same type as official, e.g.
+// SameSignature(Equal[[]int, int], slices.Equal[[]int, int])
+func SameSignature[F any](ours F, official F) {}
+
+// Returns the names of the exported functions in the given standard library
+// package, read from its source in GOROOT, so that tests can check we keep up
+// with it as Go is upgraded.
+func StdlibFunctions(t *testing.T, importPath string) []string {
+	t.Helper()
+
+	pkg, err := build.Import(importPath, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{}
+	fset := token.NewFileSet()
+	for _, name := range pkg.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, name), nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Name.IsExported() {
+				names = append(names, funcDecl.Name.Name)
+			}
+		}
+	}
+	return names
+}
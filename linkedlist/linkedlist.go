@@ -0,0 +1,235 @@
+package linkedlist
+
+// List is a doubly linked list: a type-safe alternative to container/list.
+//
+// Unlike list.List, inserting or removing anywhere in the list is O(1) given an
+// element to insert next to. Elements returned by the Push and Insert methods
+// are handles which stay valid however the rest of the list changes, until the
+// element itself is removed. The zero value is an empty list ready to use.
+type List[T any] struct {
+	// sentinel element: root.next is the front of the list and root.prev is
+	// the back, so inserting and removing never needs to special-case the ends
+	root Element[T]
+	len  int
+}
+
+type Element[T any] struct {
+	Value T
+
+	next *Element[T]
+	prev *Element[T]
+	// the list the element belongs to, or nil once it has been removed
+	list *List[T]
+}
+
+// Returns the next element, or nil if e is the last element
+func (e *Element[T]) Next() *Element[T] {
+	if e.list == nil || e.next == &e.list.root {
+		return nil
+	}
+	return e.next
+}
+
+// Returns the previous element, or nil if e is the first element
+func (e *Element[T]) Prev() *Element[T] {
+	if e.list == nil || e.prev == &e.list.root {
+		return nil
+	}
+	return e.prev
+}
+
+func New[T any]() *List[T] {
+	return &List[T]{}
+}
+
+func NewFromSlice[T any](slice []T) *List[T] {
+	l := New[T]()
+	for _, value := range slice {
+		l.PushBack(value)
+	}
+	return l
+}
+
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+func (l *List[T]) IsEmpty() bool {
+	return l.len == 0
+}
+
+// Returns the first element, or nil if the list is empty
+func (l *List[T]) Front() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Returns the last element, or nil if the list is empty
+func (l *List[T]) Back() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *List[T]) PushFront(value T) *Element[T] {
+	l.lazyInit()
+	return l.insertAfter(value, &l.root)
+}
+
+func (l *List[T]) PushBack(value T) *Element[T] {
+	l.lazyInit()
+	return l.insertAfter(value, l.root.prev)
+}
+
+// Inserts the value immediately before mark and returns its element. Returns
+// nil if mark is not in the list.
+func (l *List[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insertAfter(value, mark.prev)
+}
+
+// Inserts the value immediately after mark and returns its element. Returns
+// nil if mark is not in the list.
+func (l *List[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insertAfter(value, mark)
+}
+
+func (l *List[T]) insertAfter(value T, at *Element[T]) *Element[T] {
+	e := &Element[T]{Value: value, list: l}
+	l.link(e, at)
+	l.len++
+	return e
+}
+
+// Removes the element from the list. Returns false if the element is not in
+// the list (e.g. because it has already been removed).
+func (l *List[T]) Remove(e *Element[T]) bool {
+	if e.list != l {
+		return false
+	}
+	l.unlink(e)
+	// clearing the pointers so that a removed element doesn't keep the rest of
+	// the list from being garbage collected
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	l.len--
+	return true
+}
+
+// Moves the element to the front of the list. Returns false if the element is
+// not in the list.
+func (l *List[T]) MoveToFront(e *Element[T]) bool {
+	if e.list != l {
+		return false
+	}
+	l.move(e, &l.root)
+	return true
+}
+
+// Moves the element to the back of the list. Returns false if the element is
+// not in the list.
+func (l *List[T]) MoveToBack(e *Element[T]) bool {
+	if e.list != l {
+		return false
+	}
+	l.move(e, l.root.prev)
+	return true
+}
+
+// Moves the element to immediately before mark. Returns false if either
+// element is not in the list.
+func (l *List[T]) MoveBefore(e *Element[T], mark *Element[T]) bool {
+	if e.list != l || mark.list != l {
+		return false
+	}
+	l.move(e, mark.prev)
+	return true
+}
+
+// Moves the element to immediately after mark. Returns false if either element
+// is not in the list.
+func (l *List[T]) MoveAfter(e *Element[T], mark *Element[T]) bool {
+	if e.list != l || mark.list != l {
+		return false
+	}
+	l.move(e, mark)
+	return true
+}
+
+// Moves e to immediately after at
+func (l *List[T]) move(e *Element[T], at *Element[T]) {
+	if e == at || e.prev == at {
+		return
+	}
+	l.unlink(e)
+	l.link(e, at)
+}
+
+// Links e in immediately after at
+func (l *List[T]) link(e *Element[T], at *Element[T]) {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+func (l *List[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+// Returns an iterator over the elements from front to back. The iterator calls
+// yield for each element until yield returns false. It's safe to remove the
+// element passed to yield.
+func (l *List[T]) FromFront() func(yield func(*Element[T]) bool) {
+	return func(yield func(*Element[T]) bool) {
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Returns an iterator over the elements from back to front. The iterator calls
+// yield for each element until yield returns false. It's safe to remove the
+// element passed to yield.
+func (l *List[T]) FromBack() func(yield func(*Element[T]) bool) {
+	return func(yield func(*Element[T]) bool) {
+		for e := l.Back(); e != nil; {
+			prev := e.Prev()
+			if !yield(e) {
+				return
+			}
+			e = prev
+		}
+	}
+}
+
+// Returns the values from front to back
+func (l *List[T]) ToSlice() []T {
+	result := make([]T, 0, l.len)
+	l.FromFront()(func(e *Element[T]) bool {
+		result = append(result, e.Value)
+		return true
+	})
+	return result
+}
@@ -0,0 +1,228 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+	"github.com/jesseduffield/generics/slices"
+)
+
+func TestPushAndInsert(t *testing.T) {
+	l := New[int]()
+	two := l.PushBack(2)
+	l.PushFront(1)
+	four := l.PushBack(4)
+	l.InsertBefore(3, four)
+	l.InsertAfter(5, four)
+
+	testutils.ExpectSlice(t, []int{1, 2, 3, 4, 5}, l.ToSlice())
+	if l.Len() != 5 {
+		t.Errorf("Len() = %v, expected %v", l.Len(), 5)
+	}
+	if two.Next().Value != 3 || two.Prev().Value != 1 {
+		t.Errorf("neighbours of 2 are %v and %v, expected %v and %v", two.Prev().Value, two.Next().Value, 1, 3)
+	}
+	if l.Front().Prev() != nil || l.Back().Next() != nil {
+		t.Errorf("expected no elements beyond the ends of the list")
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var l List[string]
+	if !l.IsEmpty() || l.Front() != nil || l.Back() != nil {
+		t.Errorf("expected zero value to be an empty list")
+	}
+	l.PushBack("a")
+	testutils.ExpectSlice(t, []string{"a"}, l.ToSlice())
+}
+
+func TestRemove(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3})
+	middle := l.Front().Next()
+
+	if !l.Remove(middle) {
+		t.Errorf("Remove() = false, expected true")
+	}
+	testutils.ExpectSlice(t, []int{1, 3}, l.ToSlice())
+
+	// removing twice, or removing via another list, does nothing
+	if l.Remove(middle) {
+		t.Errorf("Remove() of a removed element = true, expected false")
+	}
+	other := NewFromSlice([]int{1})
+	if l.Remove(other.Front()) {
+		t.Errorf("Remove() of another list's element = true, expected false")
+	}
+	if middle.Next() != nil || middle.Prev() != nil {
+		t.Errorf("expected removed element to have no neighbours")
+	}
+	if l.InsertBefore(4, middle) != nil || l.InsertAfter(4, other.Front()) != nil {
+		t.Errorf("expected insertion next to a foreign element to return nil")
+	}
+
+	testutils.ExpectSlice(t, []int{1, 3}, l.ToSlice())
+	testutils.ExpectSlice(t, []int{1}, other.ToSlice())
+}
+
+func TestMove(t *testing.T) {
+	l := New[string]()
+	a := l.PushBack("a")
+	b := l.PushBack("b")
+	c := l.PushBack("c")
+
+	tests := []struct {
+		move     func() bool
+		expected []string
+	}{
+		{func() bool { return l.MoveToFront(c) }, []string{"c", "a", "b"}},
+		{func() bool { return l.MoveToFront(c) }, []string{"c", "a", "b"}},
+		{func() bool { return l.MoveToBack(c) }, []string{"a", "b", "c"}},
+		{func() bool { return l.MoveBefore(c, a) }, []string{"c", "a", "b"}},
+		{func() bool { return l.MoveAfter(c, b) }, []string{"a", "b", "c"}},
+		{func() bool { return l.MoveAfter(a, a) }, []string{"a", "b", "c"}},
+		{func() bool { return l.MoveBefore(b, b) }, []string{"a", "b", "c"}},
+	}
+	for _, test := range tests {
+		if !test.move() {
+			t.Errorf("expected move to return true")
+		}
+		testutils.ExpectSlice(t, test.expected, l.ToSlice())
+	}
+
+	other := NewFromSlice([]string{"x"})
+	if l.MoveToFront(other.Front()) || l.MoveToBack(other.Front()) || l.MoveBefore(a, other.Front()) || l.MoveAfter(other.Front(), a) {
+		t.Errorf("expected moving a foreign element to return false")
+	}
+}
+
+func TestMoveEnds(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		move     func(l *List[int]) bool
+		expected []int
+	}{
+		{"head to front", []int{1, 2, 3}, func(l *List[int]) bool { return l.MoveToFront(l.Front()) }, []int{1, 2, 3}},
+		{"tail to back", []int{1, 2, 3}, func(l *List[int]) bool { return l.MoveToBack(l.Back()) }, []int{1, 2, 3}},
+		{"head to back", []int{1, 2, 3}, func(l *List[int]) bool { return l.MoveToBack(l.Front()) }, []int{2, 3, 1}},
+		{"tail to front", []int{1, 2, 3}, func(l *List[int]) bool { return l.MoveToFront(l.Back()) }, []int{3, 1, 2}},
+		{"swap pair", []int{1, 2}, func(l *List[int]) bool { return l.MoveToFront(l.Back()) }, []int{2, 1}},
+		{"only element to front", []int{1}, func(l *List[int]) bool { return l.MoveToFront(l.Front()) }, []int{1}},
+		{"only element to back", []int{1}, func(l *List[int]) bool { return l.MoveToBack(l.Front()) }, []int{1}},
+		{"head before itself", []int{1, 2}, func(l *List[int]) bool { return l.MoveBefore(l.Front(), l.Front()) }, []int{1, 2}},
+		{"tail after itself", []int{1, 2}, func(l *List[int]) bool { return l.MoveAfter(l.Back(), l.Back()) }, []int{1, 2}},
+		{"tail after head", []int{1, 2, 3}, func(l *List[int]) bool { return l.MoveAfter(l.Back(), l.Front()) }, []int{1, 3, 2}},
+		{"head before tail", []int{1, 2, 3}, func(l *List[int]) bool { return l.MoveBefore(l.Front(), l.Back()) }, []int{2, 1, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := NewFromSlice(test.values)
+			if !test.move(l) {
+				t.Errorf("expected move to return true")
+			}
+			testutils.ExpectSlice(t, test.expected, l.ToSlice())
+
+			// the links must agree in both directions
+			backwards := []int{}
+			l.FromBack()(func(e *Element[int]) bool {
+				backwards = append(backwards, e.Value)
+				return true
+			})
+			testutils.ExpectSlice(t, slices.Reverse(test.expected), backwards)
+
+			if l.Len() != len(test.values) {
+				t.Errorf("Len() = %v, expected %v", l.Len(), len(test.values))
+			}
+			if l.Front().Prev() != nil || l.Back().Next() != nil {
+				t.Errorf("expected the ends to have no outer neighbours")
+			}
+		})
+	}
+}
+
+func TestIterators(t *testing.T) {
+	l := NewFromSlice([]int{1, 2, 3, 4})
+
+	backwards := []int{}
+	l.FromBack()(func(e *Element[int]) bool {
+		backwards = append(backwards, e.Value)
+		return true
+	})
+	testutils.ExpectSlice(t, []int{4, 3, 2, 1}, backwards)
+
+	// stopping early
+	visited := []int{}
+	l.FromFront()(func(e *Element[int]) bool {
+		visited = append(visited, e.Value)
+		return e.Value < 2
+	})
+	testutils.ExpectSlice(t, []int{1, 2}, visited)
+
+	// removing while iterating
+	l.FromFront()(func(e *Element[int]) bool {
+		if e.Value%2 == 0 {
+			l.Remove(e)
+		}
+		return true
+	})
+	testutils.ExpectSlice(t, []int{1, 3}, l.ToSlice())
+}
+
+// Applies random operations to both a linked list and a slice of its elements,
+// checking that they agree in both directions after every step.
+func TestAgainstSliceModel(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	l := New[int]()
+	model := []*Element[int]{}
+
+	for i := 0; i < 2000; i++ {
+		value := random.Int()
+		switch random.Intn(6) {
+		case 0:
+			model = slices.Prepend(model, l.PushFront(value))
+		case 1:
+			model = append(model, l.PushBack(value))
+		case 2:
+			if len(model) > 0 {
+				index := random.Intn(len(model))
+				model = slices.Insert(model, index, l.InsertBefore(value, model[index]))
+			}
+		case 3:
+			if len(model) > 0 {
+				index := random.Intn(len(model))
+				model = slices.Insert(model, index+1, l.InsertAfter(value, model[index]))
+			}
+		case 4:
+			if len(model) > 0 {
+				index := random.Intn(len(model))
+				l.Remove(model[index])
+				model = slices.Remove(model, index)
+			}
+		case 5:
+			if len(model) > 0 {
+				e, mark := model[random.Intn(len(model))], model[random.Intn(len(model))]
+				l.MoveBefore(e, mark)
+				if e != mark {
+					model = slices.Remove(model, slices.Index(model, e))
+					model = slices.Insert(model, slices.Index(model, mark), e)
+				}
+			}
+		}
+
+		forwards := []*Element[int]{}
+		l.FromFront()(func(e *Element[int]) bool {
+			forwards = append(forwards, e)
+			return true
+		})
+		backwards := []*Element[int]{}
+		l.FromBack()(func(e *Element[int]) bool {
+			backwards = append(backwards, e)
+			return true
+		})
+		if !slices.Equal(model, forwards) || !slices.Equal(slices.Reverse(model), backwards) || l.Len() != len(model) {
+			t.Fatalf("step %v: list does not match model", i)
+		}
+	}
+}
@@ -25,6 +25,7 @@ func (l *ComparableList[T]) EqualSlice(other []T) bool {
 }
 
 func (l *ComparableList[T]) Compact() {
+	l.prepareForMutation()
 	l.slice = slices.Compact(l.slice)
 }
 
@@ -35,8 +36,3 @@ func (l *ComparableList[T]) Index(needle T) int {
 func (l *ComparableList[T]) Contains(needle T) bool {
 	return slices.Contains(l.slice, needle)
 }
-
-// Sorts in-place
-func (l *ComparableList[T]) SortFunc(test func(a T, b T) bool) {
-	slices.SortFunc(l.slice, test)
-}
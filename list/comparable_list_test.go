@@ -136,3 +136,22 @@ func TestContainsFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestSetOperations(t *testing.T) {
+	list := NewComparableFromSlice([]string{"main", "feature", "bugfix"})
+	other := NewFromSlice([]string{"bugfix", "release"})
+
+	testutils.ExpectSlice(t, []string{"bugfix"}, list.Intersect(other).ToSlice())
+	testutils.ExpectSlice(t, []string{"main", "feature"}, list.Difference(other).ToSlice())
+	testutils.ExpectSlice(t, []string{"main", "feature", "bugfix", "release"}, list.Union(other).ToSlice())
+	testutils.ExpectSlice(t, []string{"main", "feature", "release"}, list.SymmetricDifference(other).ToSlice())
+}
+
+func TestDiff(t *testing.T) {
+	list := NewComparableFromSlice([]string{"a", "b", "c"})
+	other := NewFromSlice([]string{"b", "c", "d"})
+
+	edits := list.Diff(other)
+	testutils.ExpectSlice(t, []string{"b", "c", "d"}, list.ApplyEdits(other, edits).ToSlice())
+	testutils.ExpectSlice(t, []string{"b", "c"}, list.LongestCommonSubsequence(other).ToSlice())
+}
@@ -0,0 +1,132 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Lists encode as a plain array of their elements, so a *List[T] can be used
+// directly as a field in JSON or YAML config structs. An empty (or nil) list
+// encodes as an empty array rather than null.
+
+func (l *List[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.sliceForEncoding())
+}
+
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	// decoding into a fresh slice rather than l.slice, which json would reuse
+	// and which may be shared with the caller
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+	l.slice = slice
+	l.shared = false
+	return nil
+}
+
+func (l *List[T]) MarshalYAML() (interface{}, error) {
+	return l.sliceForEncoding(), nil
+}
+
+func (l *List[T]) UnmarshalYAML(value *yaml.Node) error {
+	var slice []T
+	if err := value.Decode(&slice); err != nil {
+		return err
+	}
+	l.slice = slice
+	l.shared = false
+	return nil
+}
+
+// Formats the list like its underlying slice e.g. [1 2 3]
+func (l *List[T]) String() string {
+	return fmt.Sprint(l.sliceForEncoding())
+}
+
+func (l *List[T]) sliceForEncoding() []T {
+	if l == nil || l.slice == nil {
+		return []T{}
+	}
+	return l.slice
+}
+
+// The embedded list is nil when the decoder allocates a ComparableList or
+// OrderedList itself, so these initialise it before decoding.
+
+func (l *ComparableList[T]) UnmarshalJSON(data []byte) error {
+	if l.List == nil {
+		l.List = New[T]()
+	}
+	return l.List.UnmarshalJSON(data)
+}
+
+func (l *ComparableList[T]) UnmarshalYAML(value *yaml.Node) error {
+	if l.List == nil {
+		l.List = New[T]()
+	}
+	return l.List.UnmarshalYAML(value)
+}
+
+func (l *OrderedList[T]) UnmarshalJSON(data []byte) error {
+	if l.ComparableList == nil {
+		l.ComparableList = NewComparable[T]()
+	}
+	return l.ComparableList.UnmarshalJSON(data)
+}
+
+func (l *OrderedList[T]) UnmarshalYAML(value *yaml.Node) error {
+	if l.ComparableList == nil {
+		l.ComparableList = NewComparable[T]()
+	}
+	return l.ComparableList.UnmarshalYAML(value)
+}
+
+// A ComparableList or OrderedList which was never initialised (e.g. a zero
+// value struct field) also has a nil embedded list, so these encode it as an
+// empty list. They take value receivers so that lists held by value encode
+// the same way.
+
+func (l ComparableList[T]) MarshalJSON() ([]byte, error) {
+	return l.List.MarshalJSON()
+}
+
+func (l ComparableList[T]) MarshalYAML() (interface{}, error) {
+	return l.List.MarshalYAML()
+}
+
+func (l ComparableList[T]) String() string {
+	return l.List.String()
+}
+
+func (l OrderedList[T]) MarshalJSON() ([]byte, error) {
+	return l.embeddedList().MarshalJSON()
+}
+
+func (l OrderedList[T]) MarshalYAML() (interface{}, error) {
+	return l.embeddedList().MarshalYAML()
+}
+
+func (l OrderedList[T]) String() string {
+	return l.embeddedList().String()
+}
+
+func (l OrderedList[T]) embeddedList() *List[T] {
+	if l.ComparableList == nil {
+		return nil
+	}
+	return l.List
+}
+
+var (
+	_ json.Marshaler   = OrderedList[int]{}
+	_ yaml.Marshaler   = OrderedList[int]{}
+	_ fmt.Stringer     = OrderedList[int]{}
+	_ json.Marshaler   = (*OrderedList[int])(nil)
+	_ json.Unmarshaler = (*OrderedList[int])(nil)
+	_ yaml.Marshaler   = (*OrderedList[int])(nil)
+	_ yaml.Unmarshaler = (*OrderedList[int])(nil)
+	_ fmt.Stringer     = (*OrderedList[int])(nil)
+)
@@ -0,0 +1,127 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+	"gopkg.in/yaml.v3"
+)
+
+type config struct {
+	Names   *List[string]           `json:"names" yaml:"names"`
+	Tags    *ComparableList[string] `json:"tags" yaml:"tags"`
+	Numbers *OrderedList[int]       `json:"numbers" yaml:"numbers"`
+}
+
+func TestJSON(t *testing.T) {
+	original := config{
+		Names:   NewFromSlice([]string{"a", "b"}),
+		Tags:    NewComparable[string](),
+		Numbers: NewOrderedFromSlice([]int{3, 1, 2}),
+	}
+
+	data, err := json.Marshal(original)
+	testutils.ExpectNilError(t, err)
+	expected := `{"names":["a","b"],"tags":[],"numbers":[3,1,2]}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal() = %v, expected %v", string(data), expected)
+	}
+
+	var decoded config
+	testutils.ExpectNilError(t, json.Unmarshal(data, &decoded))
+	testutils.ExpectSlice(t, []string{"a", "b"}, decoded.Names.ToSlice())
+	testutils.ExpectSlice(t, []string{}, decoded.Tags.ToSlice())
+	testutils.ExpectSlice(t, []int{3, 1, 2}, decoded.Numbers.ToSlice())
+
+	testutils.ExpectError(t, json.Unmarshal([]byte(`{"numbers":["x"]}`), &decoded), "json: cannot unmarshal string into .0 of type int")
+}
+
+func TestYAML(t *testing.T) {
+	original := config{
+		Names:   NewFromSlice([]string{"a", "b"}),
+		Tags:    NewComparable[string](),
+		Numbers: NewOrderedFromSlice([]int{3, 1, 2}),
+	}
+
+	data, err := yaml.Marshal(original)
+	testutils.ExpectNilError(t, err)
+	expected := "names:\n    - a\n    - b\ntags: []\nnumbers:\n    - 3\n    - 1\n    - 2\n"
+	if string(data) != expected {
+		t.Errorf("yaml.Marshal() = %q, expected %q", string(data), expected)
+	}
+
+	var decoded config
+	testutils.ExpectNilError(t, yaml.Unmarshal(data, &decoded))
+	testutils.ExpectSlice(t, []string{"a", "b"}, decoded.Names.ToSlice())
+	testutils.ExpectSlice(t, []string{}, decoded.Tags.ToSlice())
+	testutils.ExpectSlice(t, []int{3, 1, 2}, decoded.Numbers.ToSlice())
+
+	testutils.ExpectError(t, yaml.Unmarshal([]byte("numbers: [x]"), &decoded), "yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `x` into int")
+}
+
+func TestMarshalZeroValue(t *testing.T) {
+	type values struct {
+		Tags    ComparableList[string] `json:"tags" yaml:"tags"`
+		Numbers OrderedList[int]       `json:"numbers" yaml:"numbers"`
+	}
+
+	var zero values
+	data, err := json.Marshal(&zero)
+	testutils.ExpectNilError(t, err)
+	expected := `{"tags":[],"numbers":[]}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal() = %v, expected %v", string(data), expected)
+	}
+
+	data, err = yaml.Marshal(&zero)
+	testutils.ExpectNilError(t, err)
+	expectedYAML := "tags: []\nnumbers: []\n"
+	if string(data) != expectedYAML {
+		t.Errorf("yaml.Marshal() = %q, expected %q", string(data), expectedYAML)
+	}
+
+	if result := zero.Tags.String(); result != "[]" {
+		t.Errorf("String() = %v, expected %v", result, "[]")
+	}
+	if result := zero.Numbers.String(); result != "[]" {
+		t.Errorf("String() = %v, expected %v", result, "[]")
+	}
+
+	// held by value rather than through a pointer
+	data, err = json.Marshal(struct{ Numbers OrderedList[int] }{*NewOrderedFromSlice([]int{3, 1})})
+	testutils.ExpectNilError(t, err)
+	if string(data) != `{"Numbers":[3,1]}` {
+		t.Errorf("json.Marshal() = %v, expected %v", string(data), `{"Numbers":[3,1]}`)
+	}
+}
+
+func TestUnmarshalDoesNotModifySharedSlice(t *testing.T) {
+	slice := []int{1, 2, 3}
+	list := NewCopyOnWriteFromSlice(slice)
+	testutils.ExpectNilError(t, json.Unmarshal([]byte(`[4, 5]`), list))
+	testutils.ExpectSlice(t, []int{4, 5}, list.ToSlice())
+	testutils.ExpectSlice(t, []int{1, 2, 3}, slice)
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		list     fmt.Stringer
+		expected string
+	}{
+		{NewFromSlice([]int{1, 2, 3}), "[1 2 3]"},
+		{New[string](), "[]"},
+		{NewComparableFromSlice([]string{"a"}), "[a]"},
+		{NewOrderedFromSlice([]float64{1.5}), "[1.5]"},
+	}
+	for _, test := range tests {
+		if test.list.String() != test.expected {
+			t.Errorf("String() = %v, expected %v", test.list.String(), test.expected)
+		}
+	}
+
+	if result := fmt.Sprintf("%v", NewFromSlice([]int{1, 2})); result != "[1 2]" {
+		t.Errorf("Sprintf() = %v, expected %v", result, "[1 2]")
+	}
+}
@@ -1,5 +1,7 @@
 package list
 
+//go:generate go run ../internal/listgen
+
 import "github.com/jesseduffield/generics/slices"
 
 // List is a struct which wraps a slice and provides convenience methods for it.
@@ -8,41 +10,115 @@ import "github.com/jesseduffield/generics/slices"
 
 type List[T any] struct {
 	slice []T
+
+	// See NewCopyOnWrite
+	copyOnWrite bool
+	// true if the slice may be visible outside the list, meaning we need to
+	// copy it before mutating it
+	shared bool
 }
 
 func New[T any]() *List[T] {
 	return &List[T]{}
 }
 
+// Note that the list uses the given slice directly, so mutating the list may
+// mutate the slice. If that's a problem, use NewFromSliceCopy or
+// NewCopyOnWriteFromSlice.
 func NewFromSlice[T any](slice []T) *List[T] {
 	return &List[T]{slice: slice}
 }
 
+// Like NewFromSlice but the list gets its own copy of the slice
+func NewFromSliceCopy[T any](slice []T) *List[T] {
+	return &List[T]{slice: slices.Clone(slice)}
+}
+
+// Returns a list in copy-on-write mode. In this mode, ToSlice shares the
+// list's slice with the caller and the list copies it before its next mutation,
+// so the caller's slice never changes. The caller must treat the slice as
+// read-only.
+func NewCopyOnWrite[T any]() *List[T] {
+	return &List[T]{copyOnWrite: true}
+}
+
+// Returns a list in copy-on-write mode (see NewCopyOnWrite) which shares the
+// given slice until its first mutation.
+func NewCopyOnWriteFromSlice[T any](slice []T) *List[T] {
+	return &List[T]{slice: slice, copyOnWrite: true, shared: true}
+}
+
+// Returns the list's underlying slice. For lists in copy-on-write mode, the
+// slice must be treated as read-only; the list itself will never modify it.
 func (l *List[T]) ToSlice() []T {
+	if l.copyOnWrite {
+		l.shared = true
+		// clipping the capacity so that appending to the slice can't write
+		// into the list's spare capacity
+		return slices.Clip(l.slice)
+	}
 	return l.slice
 }
 
+func (l *List[T]) prepareForMutation() {
+	if l.shared {
+		l.slice = slices.Clone(l.slice)
+		l.shared = false
+	}
+}
+
 // Mutative methods
 
 func (l *List[T]) Push(v T) {
+	l.prepareForMutation()
 	l.slice = append(l.slice, v)
 }
 
 func (l *List[T]) Pop() T {
+	l.prepareForMutation()
 	var value T
 	value, l.slice = slices.Pop(l.slice)
 	return value
 }
 
+// Removes the first item of the list and returns it
+func (l *List[T]) Shift() T {
+	l.prepareForMutation()
+	var value T
+	value, l.slice = slices.Shift(l.slice)
+	return value
+}
+
+// Like Pop but returns false rather than panicking if the list is empty
+func (l *List[T]) TryPop() (T, bool) {
+	l.prepareForMutation()
+	var value T
+	var ok bool
+	value, l.slice, ok = slices.PopOK(l.slice)
+	return value, ok
+}
+
+// Like Shift but returns false rather than panicking if the list is empty
+func (l *List[T]) TryShift() (T, bool) {
+	l.prepareForMutation()
+	var value T
+	var ok bool
+	value, l.slice, ok = slices.ShiftOK(l.slice)
+	return value, ok
+}
+
 func (l *List[T]) Insert(index int, values ...T) {
+	l.prepareForMutation()
 	l.slice = slices.Insert(l.slice, index, values...)
 }
 
 func (l *List[T]) Append(values ...T) {
+	l.prepareForMutation()
 	l.slice = append(l.slice, values...)
 }
 
 func (l *List[T]) Prepend(values ...T) {
+	l.prepareForMutation()
 	l.slice = append(values, l.slice...)
 }
 
@@ -51,21 +127,68 @@ func (l *List[T]) Remove(index int) {
 }
 
 func (l *List[T]) Delete(from int, to int) {
+	l.prepareForMutation()
 	l.slice = slices.Delete(l.slice, from, to)
 }
 
+// Removes the element at 'fromIndex' and then inserts it at 'toIndex'
+func (l *List[T]) Move(fromIndex int, toIndex int) {
+	l.prepareForMutation()
+	l.slice = slices.Move(l.slice, fromIndex, toIndex)
+}
+
+func (l *List[T]) Swap(index1 int, index2 int) {
+	l.prepareForMutation()
+	slices.Swap(l.slice, index1, index2)
+}
+
+// Replaces consecutive runs of equal elements with a single copy, using eq to
+// compare elements.
+func (l *List[T]) CompactFunc(eq func(a T, b T) bool) {
+	l.prepareForMutation()
+	l.slice = slices.CompactFunc(l.slice, eq)
+}
+
+// Increases the list's capacity to guarantee space for another n elements.
+func (l *List[T]) Grow(n int) {
+	l.prepareForMutation()
+	l.slice = slices.Grow(l.slice, n)
+}
+
+// Removes unused capacity from the list.
+func (l *List[T]) Clip() {
+	l.prepareForMutation()
+	l.slice = slices.Clip(l.slice)
+}
+
 func (l *List[T]) FilterInPlace(test func(value T) bool) {
+	l.prepareForMutation()
 	l.slice = slices.FilterInPlace(l.slice, test)
 }
 
 func (l *List[T]) MapInPlace(f func(value T) T) {
+	l.prepareForMutation()
 	slices.MapInPlace(l.slice, f)
 }
 
 func (l *List[T]) ReverseInPlace() {
+	l.prepareForMutation()
 	slices.ReverseInPlace(l.slice)
 }
 
+// Sorts in-place. This sort is not guaranteed to be stable. See
+// slices.SortFunc for the meaning of cmp, and slices.By for building it.
+func (l *List[T]) SortFunc(cmp func(a T, b T) int) {
+	l.prepareForMutation()
+	slices.SortFunc(l.slice, cmp)
+}
+
+// Sorts in-place, keeping the original order of equal elements.
+func (l *List[T]) SortStableFunc(cmp func(a T, b T) int) {
+	l.prepareForMutation()
+	slices.SortStableFunc(l.slice, cmp)
+}
+
 // Non-mutative methods
 
 // Similar to Append but we leave the original slice untouched and return a new list
@@ -77,6 +200,18 @@ func (l *List[T]) Filter(test func(value T) bool) *List[T] {
 	return NewFromSlice(slices.Filter(l.slice, test))
 }
 
+func (l *List[T]) FilterWithIndex(test func(value T, index int) bool) *List[T] {
+	return NewFromSlice(slices.FilterWithIndex(l.slice, test))
+}
+
+func (l *List[T]) TryFilter(test func(value T) (bool, error)) (*List[T], error) {
+	return tryNewFromSlice(slices.TryFilter(l.slice, test))
+}
+
+func (l *List[T]) TryFilterWithIndex(test func(value T, index int) (bool, error)) (*List[T], error) {
+	return tryNewFromSlice(slices.TryFilterWithIndex(l.slice, test))
+}
+
 // Unfortunately this does not support mapping from one type to another
 // because Go does not yet (and may never) support methods defining their own
 // type parameters. For that functionality you'll need to use the standalone
@@ -85,6 +220,82 @@ func (l *List[T]) Map(f func(value T) T) *List[T] {
 	return NewFromSlice(slices.Map(l.slice, f))
 }
 
+func (l *List[T]) MapWithIndex(f func(value T, index int) T) *List[T] {
+	return NewFromSlice(slices.MapWithIndex(l.slice, f))
+}
+
+func (l *List[T]) TryMap(f func(value T) (T, error)) (*List[T], error) {
+	return tryNewFromSlice(slices.TryMap(l.slice, f))
+}
+
+func (l *List[T]) TryMapWithIndex(f func(value T, index int) (T, error)) (*List[T], error) {
+	return tryNewFromSlice(slices.TryMapWithIndex(l.slice, f))
+}
+
+func (l *List[T]) FilterMap(f func(value T) (T, bool)) *List[T] {
+	return NewFromSlice(slices.FilterMap(l.slice, f))
+}
+
+func (l *List[T]) FilterMapWithIndex(f func(value T, index int) (T, bool)) *List[T] {
+	return NewFromSlice(slices.FilterMapWithIndex(l.slice, f))
+}
+
+func (l *List[T]) TryFilterMap(f func(value T) (T, bool, error)) (*List[T], error) {
+	return tryNewFromSlice(slices.TryFilterMap(l.slice, f))
+}
+
+func (l *List[T]) TryFilterMapWithIndex(f func(value T, index int) (T, bool, error)) (*List[T], error) {
+	return tryNewFromSlice(slices.TryFilterMapWithIndex(l.slice, f))
+}
+
+func (l *List[T]) FlatMap(f func(value T) []T) *List[T] {
+	return NewFromSlice(slices.FlatMap(l.slice, f))
+}
+
+func (l *List[T]) FlatMapWithIndex(f func(value T, index int) []T) *List[T] {
+	return NewFromSlice(slices.FlatMapWithIndex(l.slice, f))
+}
+
+func (l *List[T]) ForEach(f func(value T)) {
+	slices.ForEach(l.slice, f)
+}
+
+func (l *List[T]) ForEachWithIndex(f func(value T, index int)) {
+	slices.ForEachWithIndex(l.slice, f)
+}
+
+func (l *List[T]) TryForEach(f func(value T) error) error {
+	return slices.TryForEach(l.slice, f)
+}
+
+func (l *List[T]) TryForEachWithIndex(f func(value T, index int) error) error {
+	return slices.TryForEachWithIndex(l.slice, f)
+}
+
+func (l *List[T]) Find(f func(value T) bool) (T, bool) {
+	return slices.Find(l.slice, f)
+}
+
+func (l *List[T]) FindMap(f func(value T) (T, bool)) (T, bool) {
+	return slices.FindMap(l.slice, f)
+}
+
+// Returns a list of the elements which pass the test and a list of those
+// which don't
+func (l *List[T]) Partition(test func(value T) bool) (*List[T], *List[T]) {
+	left, right := slices.Partition(l.slice, test)
+	return NewFromSlice(left), NewFromSlice(right)
+}
+
+func (l *List[T]) EqualFunc(other *List[T], eq func(a T, b T) bool) bool {
+	return slices.EqualFunc(l.slice, other.slice, eq)
+}
+
+// See slices.CompareFunc
+func (l *List[T]) CompareFunc(other *List[T], cmp func(a T, b T) int) int {
+	return slices.CompareFunc(l.slice, other.slice, cmp)
+}
+
 func (l *List[T]) Clone() *List[T] {
 	return NewFromSlice(slices.Clone(l.slice))
 }
@@ -105,6 +316,10 @@ func (l *List[T]) ContainsFunc(f func(T) bool) bool {
 	return slices.ContainsFunc(l.slice, f)
 }
 
+func (l *List[T]) IsSortedFunc(cmp func(a T, b T) int) bool {
+	return slices.IsSortedFunc(l.slice, cmp)
+}
+
 func (l *List[T]) Reverse() *List[T] {
 	return NewFromSlice(slices.Reverse(l.slice))
 }
@@ -120,3 +335,55 @@ func (l *List[T]) Len() int {
 func (l *List[T]) Get(index int) T {
 	return l.slice[index]
 }
+
+// Like Get but returns false rather than panicking if the index is out of range
+func (l *List[T]) TryGet(index int) (T, bool) {
+	if index < 0 || index >= len(l.slice) {
+		var zero T
+		return zero, false
+	}
+	return l.slice[index], true
+}
+
+// Like TryGet but negative indices count back from the end of the list,
+// so At(-1) returns the last element.
+func (l *List[T]) At(index int) (T, bool) {
+	if index < 0 {
+		index += len(l.slice)
+	}
+	return l.TryGet(index)
+}
+
+// Returns the element at the given index, or the fallback value if the index
+// is out of range
+func (l *List[T]) GetOr(index int, fallback T) T {
+	if value, ok := l.TryGet(index); ok {
+		return value
+	}
+	return fallback
+}
+
+func tryNewFromSlice[T any](slice []T, err error) (*List[T], error) {
+	if err != nil {
+		return nil, err
+	}
+	return NewFromSlice(slice), nil
+}
+
+// Groups the elements by the key returned by f. Elements within each group keep
+// their original order.
+func GroupBy[T any, K comparable](l *List[T], f func(T) K) map[K]*List[T] {
+	groups := slices.GroupBy(l.slice, f)
+	result := make(map[K]*List[T], len(groups))
+	for key, group := range groups {
+		result[key] = NewFromSlice(group)
+	}
+	return result
+}
+
+// Combines the elements into a single value by calling f with the value so far
+// and each element in turn, starting from 'initial'. The same as Fold; unlike
+// the Reduce method, the value may have a different type from the elements.
+func Reduce[T any, V any](l *List[T], initial V, f func(V, T) V) V {
+	return slices.Fold(l.slice, initial, f)
+}
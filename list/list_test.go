@@ -1,6 +1,9 @@
 package list
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/jesseduffield/generics/internal/testutils"
@@ -388,3 +391,382 @@ func TestGet(t *testing.T) {
 		t.Errorf("Get(2) = %v, expected %v", list.Get(2), 3)
 	}
 }
+
+func TestSortFunc(t *testing.T) {
+	list := NewFromSlice([]int{3, 1, 2})
+	list.SortFunc(func(a int, b int) int { return b - a })
+	testutils.ExpectSlice(t, []int{3, 2, 1}, list.ToSlice())
+
+	list.SortLessFunc(func(a int, b int) bool { return a < b })
+	testutils.ExpectSlice(t, []int{1, 2, 3}, list.ToSlice())
+}
+
+func TestSortStableFunc(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+	list := NewFromSlice([]pair{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	list.SortStableFunc(func(a pair, b pair) int { return a.key - b.key })
+	testutils.ExpectSlice(t, []pair{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}, list.ToSlice())
+
+	SortStableBy(list, func(p pair) string { return p.value })
+	testutils.ExpectSlice(t, []pair{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}}, list.ToSlice())
+}
+
+func TestSortBy(t *testing.T) {
+	list := NewFromSlice([]string{"ccc", "a", "bb"})
+	SortBy(list, func(value string) int { return len(value) })
+	testutils.ExpectSlice(t, []string{"a", "bb", "ccc"}, list.ToSlice())
+}
+
+func TestIsSortedFunc(t *testing.T) {
+	cmp := func(a int, b int) int { return a - b }
+	tests := []struct {
+		slice    []int
+		expected bool
+	}{
+		{[]int{}, true},
+		{[]int{1}, true},
+		{[]int{1, 1, 2}, true},
+		{[]int{2, 1}, false},
+	}
+	for _, test := range tests {
+		list := NewFromSlice(test.slice)
+		if list.IsSortedFunc(cmp) != test.expected {
+			t.Errorf("IsSortedFunc(%v) = %v, expected %v", test.slice, list.IsSortedFunc(cmp), test.expected)
+		}
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	list := NewFromSlice([]int{1, 3, 5, 7})
+	index, found := BinarySearchFunc(list, 4, func(value int, target int) int { return value - target })
+	if index != 2 || found {
+		t.Errorf("BinarySearchFunc = %v, %v, expected %v, %v", index, found, 2, false)
+	}
+
+	index = list.BinarySearchPredicate(func(value int) bool { return value >= 4 })
+	if index != 2 {
+		t.Errorf("BinarySearchPredicate = %v, expected %v", index, 2)
+	}
+}
+
+func TestShift(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3})
+	if list.Shift() != 1 {
+		t.Errorf("Shift() did not return first element")
+	}
+	testutils.ExpectSlice(t, []int{2, 3}, list.ToSlice())
+
+	func() {
+		defer testutils.ExpectPanic(t)
+		New[int]().Shift()
+	}()
+}
+
+func TestMoveSwap(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3, 4})
+	list.Move(0, 2)
+	testutils.ExpectSlice(t, []int{2, 3, 1, 4}, list.ToSlice())
+
+	list.Swap(0, 3)
+	testutils.ExpectSlice(t, []int{4, 3, 1, 2}, list.ToSlice())
+}
+
+func TestCompactFunc(t *testing.T) {
+	list := NewFromSlice([]string{"a", "A", "b", "B", "a"})
+	list.CompactFunc(func(a string, b string) bool { return strings.EqualFold(a, b) })
+	testutils.ExpectSlice(t, []string{"a", "b", "a"}, list.ToSlice())
+}
+
+func TestWithIndex(t *testing.T) {
+	list := NewFromSlice([]int{5, 6, 7})
+
+	testutils.ExpectSlice(t, []int{5, 7, 9},
+		list.MapWithIndex(func(value int, i int) int { return value + i }).ToSlice())
+	testutils.ExpectSlice(t, []int{5, 7},
+		list.FilterWithIndex(func(value int, i int) bool { return i != 1 }).ToSlice())
+	testutils.ExpectSlice(t, []int{7, 9},
+		list.FilterMapWithIndex(func(value int, i int) (int, bool) { return value + i, i > 0 }).ToSlice())
+	testutils.ExpectSlice(t, []int{5, 6, 6, 7, 7, 7},
+		list.FlatMapWithIndex(func(value int, i int) []int {
+			result := []int{}
+			for j := 0; j <= i; j++ {
+				result = append(result, value)
+			}
+			return result
+		}).ToSlice())
+
+	indices := []int{}
+	list.ForEachWithIndex(func(value int, i int) { indices = append(indices, i) })
+	testutils.ExpectSlice(t, []int{0, 1, 2}, indices)
+}
+
+func TestFilterMapFlatMap(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3, 4})
+
+	testutils.ExpectSlice(t, []int{4, 8},
+		list.FilterMap(func(value int) (int, bool) { return value * 2, value%2 == 0 }).ToSlice())
+	testutils.ExpectSlice(t, []int{1, 1, 2, 2, 3, 3, 4, 4},
+		list.FlatMap(func(value int) []int { return []int{value, value} }).ToSlice())
+}
+
+func TestTryMethods(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3})
+	failOn := func(target int) func(int) error {
+		return func(value int) error {
+			if value == target {
+				return fmt.Errorf("failed on %d", value)
+			}
+			return nil
+		}
+	}
+
+	result, err := list.TryMap(func(value int) (int, error) { return value * 2, failOn(4)(value) })
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectSlice(t, []int{2, 4, 6}, result.ToSlice())
+
+	_, err = list.TryMap(func(value int) (int, error) { return value * 2, failOn(2)(value) })
+	testutils.ExpectError(t, err, "failed on 2")
+
+	_, err = list.TryMapWithIndex(func(value int, i int) (int, error) { return value, failOn(3)(value) })
+	testutils.ExpectError(t, err, "failed on 3")
+
+	result, err = list.TryFilter(func(value int) (bool, error) { return value > 1, nil })
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectSlice(t, []int{2, 3}, result.ToSlice())
+
+	_, err = list.TryFilterWithIndex(func(value int, i int) (bool, error) { return true, failOn(1)(value) })
+	testutils.ExpectError(t, err, "failed on 1")
+
+	result, err = list.TryFilterMap(func(value int) (int, bool, error) { return value * 10, value != 2, nil })
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectSlice(t, []int{10, 30}, result.ToSlice())
+
+	_, err = list.TryFilterMapWithIndex(func(value int, i int) (int, bool, error) { return value, true, failOn(2)(value) })
+	testutils.ExpectError(t, err, "failed on 2")
+
+	testutils.ExpectNilError(t, list.TryForEach(failOn(4)))
+	testutils.ExpectError(t, list.TryForEach(failOn(3)), "failed on 3")
+	testutils.ExpectError(t, list.TryForEachWithIndex(func(value int, i int) error { return failOn(1)(i) }), "failed on 1")
+}
+
+func TestForEach(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3})
+	sum := 0
+	list.ForEach(func(value int) { sum += value })
+	if sum != 6 {
+		t.Errorf("ForEach visited elements summing to %v, expected %v", sum, 6)
+	}
+}
+
+func TestFind(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3, 4})
+
+	value, ok := list.Find(func(value int) bool { return value > 2 })
+	if !ok || value != 3 {
+		t.Errorf("Find = %v, %v, expected %v, %v", value, ok, 3, true)
+	}
+
+	value, ok = list.Find(func(value int) bool { return value > 4 })
+	if ok || value != 0 {
+		t.Errorf("Find = %v, %v, expected %v, %v", value, ok, 0, false)
+	}
+
+	value, ok = list.FindMap(func(value int) (int, bool) { return value * 10, value > 1 })
+	if !ok || value != 20 {
+		t.Errorf("FindMap = %v, %v, expected %v, %v", value, ok, 20, true)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3, 4})
+	even, odd := list.Partition(func(value int) bool { return value%2 == 0 })
+	testutils.ExpectSlice(t, []int{2, 4}, even.ToSlice())
+	testutils.ExpectSlice(t, []int{1, 3}, odd.ToSlice())
+}
+
+func TestEqualFuncCompareFunc(t *testing.T) {
+	first := NewFromSlice([]string{"a", "b"})
+	second := NewFromSlice([]string{"A", "B"})
+	if !first.EqualFunc(second, strings.EqualFold) {
+		t.Errorf("EqualFunc = false, expected true")
+	}
+
+	if first.CompareFunc(second, strings.Compare) != 1 {
+		t.Errorf("CompareFunc = %v, expected %v", first.CompareFunc(second, strings.Compare), 1)
+	}
+}
+
+func TestStandaloneMap(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3})
+	result := Map(list, func(value int) string { return strconv.Itoa(value * 2) })
+	testutils.ExpectSlice(t, []string{"2", "4", "6"}, result.ToSlice())
+
+	_, err := TryMap(list, func(value int) (string, error) { return "", fmt.Errorf("failed on %d", value) })
+	testutils.ExpectError(t, err, "failed on 1")
+}
+
+func TestStandaloneFunctions(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3})
+
+	testutils.ExpectSlice(t, []string{"1", "1", "2", "2", "3", "3"},
+		FlatMap(list, func(value int) []string { return []string{strconv.Itoa(value), strconv.Itoa(value)} }).ToSlice())
+
+	testutils.ExpectSlice(t, []string{"1", "3"},
+		FilterMap(list, func(value int) (string, bool) { return strconv.Itoa(value), value%2 == 1 }).ToSlice())
+
+	testutils.ExpectSlice(t, []string{"1a", "2b"},
+		Zip(list, NewFromSlice([]string{"a", "b"}), func(a int, b string) string { return strconv.Itoa(a) + b }).ToSlice())
+
+	sum := Reduce(list, 0.5, func(acc float64, value int) float64 { return acc + float64(value) })
+	if sum != 6.5 {
+		t.Errorf("Reduce = %v, expected %v", sum, 6.5)
+	}
+
+	sum = Fold(list, 0.5, func(acc float64, value int) float64 { return acc + float64(value) })
+	if sum != 6.5 {
+		t.Errorf("Fold = %v, expected %v", sum, 6.5)
+	}
+
+	testutils.ExpectSlice(t, []string{"1", "12", "123"},
+		Scan(list, "", func(acc string, value int) string { return acc + strconv.Itoa(value) }).ToSlice())
+
+	difference, ok := list.ReduceRight(func(acc int, value int) int { return acc - value })
+	if difference != 0 || !ok {
+		t.Errorf("ReduceRight = %v, %v, expected %v, %v", difference, ok, 0, true)
+	}
+
+	groups := GroupBy(list, func(value int) bool { return value%2 == 0 })
+	testutils.ExpectSlice(t, []int{1, 3}, groups[false].ToSlice())
+	testutils.ExpectSlice(t, []int{2}, groups[true].ToSlice())
+}
+
+func TestTryPopTryShift(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3})
+
+	value, ok := list.TryPop()
+	if !ok || value != 3 {
+		t.Errorf("TryPop() = %v, %v, expected %v, %v", value, ok, 3, true)
+	}
+	value, ok = list.TryShift()
+	if !ok || value != 1 {
+		t.Errorf("TryShift() = %v, %v, expected %v, %v", value, ok, 1, true)
+	}
+	testutils.ExpectSlice(t, []int{2}, list.ToSlice())
+
+	empty := New[int]()
+	if _, ok := empty.TryPop(); ok {
+		t.Errorf("TryPop() on empty list returned true")
+	}
+	if _, ok := empty.TryShift(); ok {
+		t.Errorf("TryShift() on empty list returned true")
+	}
+}
+
+func TestSafeAccessors(t *testing.T) {
+	list := NewFromSlice([]int{1, 2, 3})
+
+	tests := []struct {
+		index      int
+		tryGet     int
+		tryGetOK   bool
+		at         int
+		atOK       bool
+		getOrValue int
+	}{
+		{0, 1, true, 1, true, 1},
+		{2, 3, true, 3, true, 3},
+		{3, 0, false, 0, false, -1},
+		{-1, 0, false, 3, true, -1},
+		{-3, 0, false, 1, true, -1},
+		{-4, 0, false, 0, false, -1},
+	}
+	for _, test := range tests {
+		value, ok := list.TryGet(test.index)
+		if value != test.tryGet || ok != test.tryGetOK {
+			t.Errorf("TryGet(%v) = %v, %v, expected %v, %v", test.index, value, ok, test.tryGet, test.tryGetOK)
+		}
+		value, ok = list.At(test.index)
+		if value != test.at || ok != test.atOK {
+			t.Errorf("At(%v) = %v, %v, expected %v, %v", test.index, value, ok, test.at, test.atOK)
+		}
+		if list.GetOr(test.index, -1) != test.getOrValue {
+			t.Errorf("GetOr(%v, -1) = %v, expected %v", test.index, list.GetOr(test.index, -1), test.getOrValue)
+		}
+	}
+
+	first, ok := list.First()
+	if !ok || first != 1 {
+		t.Errorf("First() = %v, %v, expected %v, %v", first, ok, 1, true)
+	}
+	last, ok := list.Last()
+	if !ok || last != 3 {
+		t.Errorf("Last() = %v, %v, expected %v, %v", last, ok, 3, true)
+	}
+	if _, ok := New[int]().First(); ok {
+		t.Errorf("First() on empty list returned true")
+	}
+}
+
+func TestNewFromSliceCopy(t *testing.T) {
+	slice := make([]int, 2, 10)
+	slice[0], slice[1] = 1, 2
+
+	list := NewFromSliceCopy(slice)
+	list.MapInPlace(func(value int) int { return value * 10 })
+	list.Push(3)
+
+	testutils.ExpectSlice(t, []int{10, 20, 3}, list.ToSlice())
+	testutils.ExpectSlice(t, []int{1, 2}, slice)
+	testutils.ExpectSlice(t, []int{1, 2, 0}, slice[:3])
+}
+
+func TestCopyOnWrite(t *testing.T) {
+	original := make([]int, 3, 10)
+	original[0], original[1], original[2] = 1, 2, 3
+
+	list := NewCopyOnWriteFromSlice(original)
+	// the list shares the caller's slice until it is mutated
+	list.Pop()
+	list.Push(4)
+	list.MapInPlace(func(value int) int { return value * 10 })
+	testutils.ExpectSlice(t, []int{10, 20, 40}, list.ToSlice())
+	testutils.ExpectSlice(t, []int{1, 2, 3, 0}, original[:4])
+
+	view := list.ToSlice()
+	if cap(view) != len(view) {
+		t.Errorf("Expected ToSlice to clip capacity, got len %v cap %v", len(view), cap(view))
+	}
+
+	// appending to the view must not write into the list's spare capacity
+	extended := append(view, 99)
+	testutils.ExpectSlice(t, []int{10, 20, 40, 99}, extended)
+	testutils.ExpectSlice(t, []int{10, 20, 40}, list.ToSlice())
+
+	// every kind of mutation after sharing leaves the view untouched
+	mutations := []func(l *List[int]){
+		func(l *List[int]) { l.Push(5) },
+		func(l *List[int]) { l.Pop() },
+		func(l *List[int]) { l.Shift() },
+		func(l *List[int]) { l.Insert(0, 5) },
+		func(l *List[int]) { l.Delete(0, 1) },
+		func(l *List[int]) { l.Swap(0, 2) },
+		func(l *List[int]) { l.Move(0, 2) },
+		func(l *List[int]) { l.MapInPlace(func(value int) int { return -value }) },
+		func(l *List[int]) { l.FilterInPlace(func(value int) bool { return value > 10 }) },
+		func(l *List[int]) { l.ReverseInPlace() },
+		func(l *List[int]) { l.SortFunc(func(a int, b int) int { return b - a }) },
+		func(l *List[int]) { SortBy(l, func(value int) int { return -value }) },
+		func(l *List[int]) { l.PartialSort(2, func(a int, b int) int { return b - a }) },
+		func(l *List[int]) { l.NthElement(0, func(a int, b int) int { return b - a }) },
+	}
+	for _, mutate := range mutations {
+		l := NewCopyOnWrite[int]()
+		l.Append(10, 20, 40)
+		view := l.ToSlice()
+		mutate(l)
+		testutils.ExpectSlice(t, []int{10, 20, 40}, view)
+	}
+}
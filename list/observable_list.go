@@ -0,0 +1,283 @@
+package list
+
+import "github.com/jesseduffield/generics/slices"
+
+// ObservableList wraps a List and notifies subscribers of every change made to
+// it, so that e.g. a view can update incrementally rather than re-rendering the
+// whole list.
+//
+// A single method call may produce several changes. Each change describes the
+// list as left by the changes before it, so applying them in order to a copy
+// of the list (see Change.Apply) keeps the copy in sync.
+type ObservableList[T any] struct {
+	list          *List[T]
+	subscriptions []subscription[T]
+	nextID        int
+}
+
+type subscription[T any] struct {
+	id int
+	f  func(Change[T])
+}
+
+// Change is one of InsertChange, DeleteChange, MoveChange or ReplaceChange.
+type Change[T any] interface {
+	// Applies the change to the given slice, which is expected to match the
+	// list's state before the change. Mutates the original slice. Intended
+	// usage is to reassign the slice result to the input slice.
+	Apply(slice []T) []T
+}
+
+// Values were inserted starting at Index
+type InsertChange[T any] struct {
+	Index  int
+	Values []T
+}
+
+// Values were deleted starting at Index
+type DeleteChange[T any] struct {
+	Index  int
+	Values []T
+}
+
+// The element at FromIndex was removed and then inserted at ToIndex
+type MoveChange[T any] struct {
+	FromIndex int
+	ToIndex   int
+}
+
+// The elements starting at Index were replaced in-place
+type ReplaceChange[T any] struct {
+	Index     int
+	OldValues []T
+	NewValues []T
+}
+
+func (c InsertChange[T]) Apply(slice []T) []T {
+	return slices.Insert(slice, c.Index, c.Values...)
+}
+
+func (c DeleteChange[T]) Apply(slice []T) []T {
+	return slices.Delete(slice, c.Index, c.Index+len(c.Values))
+}
+
+func (c MoveChange[T]) Apply(slice []T) []T {
+	return slices.Move(slice, c.FromIndex, c.ToIndex)
+}
+
+func (c ReplaceChange[T]) Apply(slice []T) []T {
+	copy(slice[c.Index:], c.NewValues)
+	return slice
+}
+
+func NewObservable[T any]() *ObservableList[T] {
+	return &ObservableList[T]{list: New[T]()}
+}
+
+// Like NewFromSlice, the list uses the given slice directly
+func NewObservableFromSlice[T any](slice []T) *ObservableList[T] {
+	return &ObservableList[T]{list: NewFromSlice(slice)}
+}
+
+// Registers f to be called with each change. Returns a function which
+// unsubscribes f.
+func (l *ObservableList[T]) Subscribe(f func(Change[T])) func() {
+	id := l.nextID
+	l.nextID++
+	l.subscriptions = append(l.subscriptions, subscription[T]{id: id, f: f})
+
+	return func() {
+		l.subscriptions = slices.Filter(l.subscriptions, func(s subscription[T]) bool {
+			return s.id != id
+		})
+	}
+}
+
+func (l *ObservableList[T]) notify(changes ...Change[T]) {
+	for _, change := range changes {
+		for _, s := range l.subscriptions {
+			s.f(change)
+		}
+	}
+}
+
+// Read-only methods
+
+// The slice must not be modified, or subscribers won't be notified
+func (l *ObservableList[T]) ToSlice() []T {
+	return l.list.ToSlice()
+}
+
+// Returns a copy of the underlying list, for access to List's non-mutative
+// methods
+func (l *ObservableList[T]) Clone() *List[T] {
+	return l.list.Clone()
+}
+
+func (l *ObservableList[T]) Len() int {
+	return l.list.Len()
+}
+
+func (l *ObservableList[T]) IsEmpty() bool {
+	return l.list.IsEmpty()
+}
+
+func (l *ObservableList[T]) Get(index int) T {
+	return l.list.Get(index)
+}
+
+// Mutative methods
+
+func (l *ObservableList[T]) Push(value T) {
+	l.Insert(l.Len(), value)
+}
+
+func (l *ObservableList[T]) Append(values ...T) {
+	l.Insert(l.Len(), values...)
+}
+
+func (l *ObservableList[T]) Prepend(values ...T) {
+	l.Insert(0, values...)
+}
+
+func (l *ObservableList[T]) Insert(index int, values ...T) {
+	l.list.Insert(index, values...)
+	if len(values) > 0 {
+		l.notify(InsertChange[T]{Index: index, Values: slices.Clone(values)})
+	}
+}
+
+func (l *ObservableList[T]) Pop() T {
+	index := l.Len() - 1
+	value := l.list.Pop()
+	l.notify(DeleteChange[T]{Index: index, Values: []T{value}})
+	return value
+}
+
+func (l *ObservableList[T]) Shift() T {
+	value := l.list.Shift()
+	l.notify(DeleteChange[T]{Index: 0, Values: []T{value}})
+	return value
+}
+
+func (l *ObservableList[T]) TryPop() (T, bool) {
+	if l.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return l.Pop(), true
+}
+
+func (l *ObservableList[T]) TryShift() (T, bool) {
+	if l.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return l.Shift(), true
+}
+
+func (l *ObservableList[T]) Remove(index int) {
+	l.Delete(index, index+1)
+}
+
+func (l *ObservableList[T]) Delete(from int, to int) {
+	deleted := slices.Clone(l.list.slice[from:to])
+	l.list.Delete(from, to)
+	if len(deleted) > 0 {
+		l.notify(DeleteChange[T]{Index: from, Values: deleted})
+	}
+}
+
+func (l *ObservableList[T]) Move(fromIndex int, toIndex int) {
+	l.list.Move(fromIndex, toIndex)
+	if fromIndex != toIndex {
+		l.notify(MoveChange[T]{FromIndex: fromIndex, ToIndex: toIndex})
+	}
+}
+
+func (l *ObservableList[T]) Swap(index1 int, index2 int) {
+	if index1 == index2 {
+		return
+	}
+	value1, value2 := l.Get(index1), l.Get(index2)
+	l.list.Swap(index1, index2)
+	l.notify(
+		ReplaceChange[T]{Index: index1, OldValues: []T{value1}, NewValues: []T{value2}},
+		ReplaceChange[T]{Index: index2, OldValues: []T{value2}, NewValues: []T{value1}},
+	)
+}
+
+func (l *ObservableList[T]) FilterInPlace(test func(value T) bool) {
+	keep := slices.Map(l.list.slice, test)
+	l.deleteUnkept(keep)
+}
+
+// Replaces consecutive runs of equal elements with a single copy, using eq to
+// compare elements.
+func (l *ObservableList[T]) CompactFunc(eq func(a T, b T) bool) {
+	keep := make([]bool, l.Len())
+	lastKept := -1
+	for i, value := range l.list.slice {
+		if lastKept == -1 || !eq(l.list.slice[lastKept], value) {
+			keep[i] = true
+			lastKept = i
+		}
+	}
+	l.deleteUnkept(keep)
+}
+
+// Deletes the elements whose entry in keep is false, notifying subscribers of
+// each run of deleted elements
+func (l *ObservableList[T]) deleteUnkept(keep []bool) {
+	changes := []Change[T]{}
+	// going backwards so that each change's index is unaffected by the changes
+	// before it
+	for end := len(keep); end > 0; {
+		if keep[end-1] {
+			end--
+			continue
+		}
+		start := end - 1
+		for start > 0 && !keep[start-1] {
+			start--
+		}
+		changes = append(changes, DeleteChange[T]{Index: start, Values: slices.Clone(l.list.slice[start:end])})
+		end = start
+	}
+
+	i := 0
+	l.list.FilterInPlace(func(T) bool {
+		i++
+		return keep[i-1]
+	})
+	l.notify(changes...)
+}
+
+func (l *ObservableList[T]) MapInPlace(f func(value T) T) {
+	l.replaceAll(func() { l.list.MapInPlace(f) })
+}
+
+func (l *ObservableList[T]) ReverseInPlace() {
+	l.replaceAll(l.list.ReverseInPlace)
+}
+
+// Sorts in-place. This sort is not guaranteed to be stable.
+func (l *ObservableList[T]) SortFunc(cmp func(a T, b T) int) {
+	l.replaceAll(func() { l.list.SortFunc(cmp) })
+}
+
+// Sorts in-place, keeping the original order of equal elements.
+func (l *ObservableList[T]) SortStableFunc(cmp func(a T, b T) int) {
+	l.replaceAll(func() { l.list.SortStableFunc(cmp) })
+}
+
+// Calls mutate, which may rearrange or replace the elements without changing
+// their number, and notifies subscribers with a single replace change
+func (l *ObservableList[T]) replaceAll(mutate func()) {
+	if l.IsEmpty() {
+		return
+	}
+	oldValues := slices.Clone(l.list.slice)
+	mutate()
+	l.notify(ReplaceChange[T]{Index: 0, OldValues: oldValues, NewValues: slices.Clone(l.list.slice)})
+}
@@ -0,0 +1,117 @@
+package list
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+	"github.com/jesseduffield/generics/slices"
+)
+
+func TestObservableChanges(t *testing.T) {
+	list := NewObservableFromSlice([]int{1, 2, 3})
+	changes := []Change[int]{}
+	list.Subscribe(func(change Change[int]) { changes = append(changes, change) })
+
+	list.Push(4)
+	list.Insert(1, 5, 6)
+	list.Delete(0, 2)
+	list.Move(0, 3)
+	list.Swap(0, 1)
+	list.FilterInPlace(func(value int) bool { return value != 2 && value != 3 })
+	list.ReverseInPlace()
+
+	expected := []Change[int]{
+		InsertChange[int]{Index: 3, Values: []int{4}},
+		InsertChange[int]{Index: 1, Values: []int{5, 6}},
+		DeleteChange[int]{Index: 0, Values: []int{1, 5}},
+		MoveChange[int]{FromIndex: 0, ToIndex: 3},
+		ReplaceChange[int]{Index: 0, OldValues: []int{2}, NewValues: []int{3}},
+		ReplaceChange[int]{Index: 1, OldValues: []int{3}, NewValues: []int{2}},
+		DeleteChange[int]{Index: 0, Values: []int{3, 2}},
+		ReplaceChange[int]{Index: 0, OldValues: []int{4, 6}, NewValues: []int{6, 4}},
+	}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Errorf("Expected changes %v, got %v", expected, changes)
+	}
+	testutils.ExpectSlice(t, []int{6, 4}, list.ToSlice())
+}
+
+func TestObservableUnsubscribe(t *testing.T) {
+	list := NewObservable[int]()
+	count := 0
+	unsubscribe := list.Subscribe(func(change Change[int]) { count++ })
+
+	list.Push(1)
+	unsubscribe()
+	list.Push(2)
+
+	if count != 1 {
+		t.Errorf("subscriber called %v times, expected %v", count, 1)
+	}
+}
+
+func TestObservableNoOpsDoNotNotify(t *testing.T) {
+	list := NewObservableFromSlice([]int{1, 2})
+	list.Subscribe(func(change Change[int]) { t.Errorf("unexpected change %v", change) })
+
+	list.Append()
+	list.Delete(1, 1)
+	list.Move(1, 1)
+	list.Swap(0, 0)
+	list.FilterInPlace(func(int) bool { return true })
+	if _, ok := NewObservable[int]().TryPop(); ok {
+		t.Errorf("TryPop() on empty list returned true")
+	}
+}
+
+// Mirrors an observable list by applying each change to a plain slice, and
+// checks that the two agree after every random mutation.
+func TestObservableChangesReproduceList(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	list := NewObservable[int]()
+	mirror := []int{}
+	list.Subscribe(func(change Change[int]) { mirror = change.Apply(mirror) })
+
+	mutations := []func(){
+		func() { list.Push(random.Intn(10)) },
+		func() { list.Append(random.Intn(10), random.Intn(10)) },
+		func() { list.Prepend(random.Intn(10)) },
+		func() { list.Insert(random.Intn(list.Len()+1), random.Intn(10), random.Intn(10)) },
+		func() { list.TryPop() },
+		func() { list.TryShift() },
+		func() {
+			if !list.IsEmpty() {
+				list.Remove(random.Intn(list.Len()))
+			}
+		},
+		func() {
+			from := random.Intn(list.Len() + 1)
+			list.Delete(from, from+random.Intn(list.Len()-from+1))
+		},
+		func() {
+			if !list.IsEmpty() {
+				list.Move(random.Intn(list.Len()), random.Intn(list.Len()))
+			}
+		},
+		func() {
+			if !list.IsEmpty() {
+				list.Swap(random.Intn(list.Len()), random.Intn(list.Len()))
+			}
+		},
+		func() { list.FilterInPlace(func(value int) bool { return value%3 != 0 }) },
+		func() { list.CompactFunc(func(a int, b int) bool { return a == b }) },
+		func() { list.MapInPlace(func(value int) int { return (value + 1) % 10 }) },
+		func() { list.ReverseInPlace() },
+		func() { list.SortFunc(func(a int, b int) int { return a - b }) },
+		func() { list.SortStableFunc(func(a int, b int) int { return a%2 - b%2 }) },
+	}
+
+	for i := 0; i < 2000; i++ {
+		mutations[random.Intn(len(mutations))]()
+		if !slices.Equal(mirror, list.ToSlice()) {
+			t.Fatalf("step %v: mirror %v does not match list %v", i, mirror, list.ToSlice())
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package list
+
+import (
+	"github.com/jesseduffield/generics/slices"
+	"golang.org/x/exp/constraints"
+)
+
+// OrderedList is a list whose elements can be compared with < and so can be
+// sorted without a comparison function.
+type OrderedList[T constraints.Ordered] struct {
+	*ComparableList[T]
+}
+
+func NewOrdered[T constraints.Ordered]() *OrderedList[T] {
+	return &OrderedList[T]{ComparableList: NewComparable[T]()}
+}
+
+func NewOrderedFromSlice[T constraints.Ordered](slice []T) *OrderedList[T] {
+	return &OrderedList[T]{ComparableList: NewComparableFromSlice(slice)}
+}
+
+// Sorts in-place in ascending order
+func (l *OrderedList[T]) Sort() {
+	l.prepareForMutation()
+	slices.Sort(l.slice)
+}
+
+func (l *OrderedList[T]) IsSorted() bool {
+	return slices.IsSorted(l.slice)
+}
+
+// See slices.BinarySearch
+func (l *OrderedList[T]) BinarySearch(target T) int {
+	return slices.BinarySearch(l.slice, target)
+}
+
+// See slices.Compare
+func (l *OrderedList[T]) Compare(other *OrderedList[T]) int {
+	return slices.Compare(l.slice, other.slice)
+}
@@ -0,0 +1,82 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		slice    []int
+		expected []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1}, []int{1}},
+		{[]int{2, 1}, []int{1, 2}},
+		{[]int{3, 1, 2, 1}, []int{1, 1, 2, 3}},
+	}
+	for _, test := range tests {
+		list := NewOrderedFromSlice(test.slice)
+		list.Sort()
+		testutils.ExpectSlice(t, test.expected, list.ToSlice())
+		if !list.IsSorted() {
+			t.Errorf("IsSorted() = false after Sort(), expected true")
+		}
+	}
+
+	if NewOrderedFromSlice([]int{2, 1}).IsSorted() {
+		t.Errorf("IsSorted() = true, expected false")
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	tests := []struct {
+		slice    []int
+		target   int
+		expected int
+	}{
+		{[]int{}, 1, 0},
+		{[]int{1, 3, 5}, 3, 1},
+		{[]int{1, 3, 5}, 4, 2},
+		{[]int{1, 3, 5}, 6, 3},
+	}
+	for _, test := range tests {
+		list := NewOrderedFromSlice(test.slice)
+		if list.BinarySearch(test.target) != test.expected {
+			t.Errorf("BinarySearch(%v, %v) = %v, expected %v",
+				test.slice, test.target, list.BinarySearch(test.target), test.expected,
+			)
+		}
+	}
+}
+
+func TestMinMaxSum(t *testing.T) {
+	list := NewOrderedFromSlice([]int{3, 1, 4, 1, 5})
+	if list.Min() != 1 {
+		t.Errorf("Min() = %v, expected %v", list.Min(), 1)
+	}
+	if list.Max() != 5 {
+		t.Errorf("Max() = %v, expected %v", list.Max(), 5)
+	}
+	if Sum(list.List) != 14 {
+		t.Errorf("Sum() = %v, expected %v", Sum(list.List), 14)
+	}
+	if list.ArgMin() != 1 || list.ArgMax() != 4 {
+		t.Errorf("ArgMin(), ArgMax() = %v, %v, expected %v, %v", list.ArgMin(), list.ArgMax(), 1, 4)
+	}
+
+	element, index, ok := MaxElemBy(list.List, func(value int) int { return -value })
+	if element != 1 || index != 1 || !ok {
+		t.Errorf("MaxElemBy() = %v, %v, %v, expected %v, %v, %v", element, index, ok, 1, 1, true)
+	}
+
+	func() {
+		defer testutils.ExpectPanic(t)
+		NewOrdered[int]().Min()
+	}()
+	func() {
+		defer testutils.ExpectPanic(t)
+		NewOrdered[int]().Max()
+	}()
+}
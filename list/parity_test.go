@@ -0,0 +1,88 @@
+package list
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// slices functions which intentionally have no List counterpart, along with the reason
+var slicesFunctionsWithoutCounterparts = map[string]string{
+	"By":               "builds a comparator rather than operating on a slice",
+	"Collect":          "builds a slice from an iterator",
+	"Flatten":          "operates on a slice of slices",
+	"PopOK":            "see List.TryPop",
+	"ShiftOK":          "see List.TryShift",
+	"Sorted":           "builds a slice from an iterator",
+	"SortedFunc":       "builds a slice from an iterator",
+	"SortedStableFunc": "builds a slice from an iterator",
+}
+
+// Ensures that whenever a function is added to the slices package, a
+// corresponding method is added to List (or ComparableList/OrderedList, for
+// functions with stricter constraints), or a standalone function is added for
+// functions which need extra type parameters. Most of these are generated by
+// internal/listgen.
+func TestSlicesParity(t *testing.T) {
+	paths, err := filepath.Glob("../slices/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	methods := map[string]bool{}
+	listType := reflect.TypeOf(&OrderedList[int]{})
+	for i := 0; i < listType.NumMethod(); i++ {
+		methods[listType.Method(i).Name] = true
+	}
+
+	functions := map[string]bool{}
+	listPaths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range listPaths {
+		for _, funcDecl := range parseFuncDecls(t, path) {
+			if funcDecl.Recv == nil {
+				functions[funcDecl.Name.Name] = true
+			}
+		}
+	}
+
+	for _, path := range paths {
+		for _, funcDecl := range parseFuncDecls(t, path) {
+			if funcDecl.Recv != nil || !funcDecl.Name.IsExported() {
+				continue
+			}
+			name := funcDecl.Name.Name
+			if _, ok := slicesFunctionsWithoutCounterparts[name]; ok {
+				continue
+			}
+			if !methods[name] && !functions[name] {
+				t.Errorf("slices.%s has no corresponding List method or function", name)
+			}
+		}
+	}
+}
+
+func parseFuncDecls(t *testing.T, path string) []*ast.FuncDecl {
+	t.Helper()
+
+	if strings.HasSuffix(path, "_test.go") {
+		return nil
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := []*ast.FuncDecl{}
+	for _, decl := range file.Decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+			result = append(result, funcDecl)
+		}
+	}
+	return result
+}
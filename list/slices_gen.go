@@ -0,0 +1,414 @@
+// Code generated by internal/listgen; DO NOT EDIT.
+
+package list
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/jesseduffield/generics/slices"
+	"golang.org/x/exp/constraints"
+)
+
+// See slices.All
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return slices.All(l.slice)
+}
+
+// See slices.Backward
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return slices.Backward(l.slice)
+}
+
+// See slices.Values
+func (l *List[T]) Values() iter.Seq[T] {
+	return slices.Values(l.slice)
+}
+
+// See slices.AppendSeq
+func (l *List[T]) AppendSeq(seq iter.Seq[T]) {
+	l.prepareForMutation()
+	r0 := slices.AppendSeq(l.slice, seq)
+	l.slice = r0
+}
+
+// See slices.Chunk
+func (l *List[T]) Chunk(n int) iter.Seq[[]T] {
+	return slices.Chunk(l.slice, n)
+}
+
+// See slices.EqualFunc
+func EqualFunc[E1 any, E2 any](l *List[E1], s2 *List[E2], eq func(E1, E2) bool) bool {
+	return slices.EqualFunc(l.slice, s2.slice, eq)
+}
+
+// See slices.CompareFunc
+func CompareFunc[E1 any, E2 any](l *List[E1], s2 *List[E2], cmp func(E1, E2) int) int {
+	return slices.CompareFunc(l.slice, s2.slice, cmp)
+}
+
+// See slices.DeleteFunc
+func (l *List[T]) DeleteFunc(del func(T) bool) {
+	l.prepareForMutation()
+	r0 := slices.DeleteFunc(l.slice, del)
+	l.slice = r0
+}
+
+// See slices.Replace
+func (l *List[T]) Replace(i int, j int, v ...T) {
+	l.prepareForMutation()
+	r0 := slices.Replace(l.slice, i, j, v...)
+	l.slice = r0
+}
+
+// See slices.Repeat
+func (l *List[T]) Repeat(count int) *List[T] {
+	r0 := slices.Repeat(l.slice, count)
+	return NewFromSlice(r0)
+}
+
+// See slices.Max
+func (l *OrderedList[T]) Max() T {
+	return slices.Max(l.slice)
+}
+
+// See slices.MaxFunc
+func (l *List[T]) MaxFunc(cmp func(a, b T) int) T {
+	return slices.MaxFunc(l.slice, cmp)
+}
+
+// See slices.Min
+func (l *OrderedList[T]) Min() T {
+	return slices.Min(l.slice)
+}
+
+// See slices.MinFunc
+func (l *List[T]) MinFunc(cmp func(a, b T) int) T {
+	return slices.MinFunc(l.slice, cmp)
+}
+
+// See slices.BinarySearchFunc
+func BinarySearchFunc[E any, T any](l *List[E], target T, cmp func(E, T) int) (int, bool) {
+	return slices.BinarySearchFunc(l.slice, target, cmp)
+}
+
+// See slices.SortLessFunc
+func (l *List[T]) SortLessFunc(less func(a, b T) bool) {
+	l.prepareForMutation()
+	slices.SortLessFunc(l.slice, less)
+}
+
+// See slices.SortStableLessFunc
+func (l *List[T]) SortStableLessFunc(less func(a, b T) bool) {
+	l.prepareForMutation()
+	slices.SortStableLessFunc(l.slice, less)
+}
+
+// See slices.IsSortedLessFunc
+func (l *List[T]) IsSortedLessFunc(less func(a, b T) bool) bool {
+	return slices.IsSortedLessFunc(l.slice, less)
+}
+
+// See slices.BinarySearchPredicate
+func (l *List[T]) BinarySearchPredicate(ok func(T) bool) int {
+	return slices.BinarySearchPredicate(l.slice, ok)
+}
+
+// See slices.Diff
+func (l *ComparableList[T]) Diff(b *List[T]) []slices.Edit {
+	return slices.Diff(l.slice, b.slice)
+}
+
+// See slices.DiffFunc
+func (l *List[T]) DiffFunc(b *List[T], eq func(T, T) bool) []slices.Edit {
+	return slices.DiffFunc(l.slice, b.slice, eq)
+}
+
+// See slices.LongestCommonSubsequence
+func (l *ComparableList[T]) LongestCommonSubsequence(b *List[T]) *ComparableList[T] {
+	r0 := slices.LongestCommonSubsequence(l.slice, b.slice)
+	return NewComparableFromSlice(r0)
+}
+
+// See slices.ApplyEdits
+func (l *List[T]) ApplyEdits(b *List[T], edits []slices.Edit) *List[T] {
+	r0 := slices.ApplyEdits(l.slice, b.slice, edits)
+	return NewFromSlice(r0)
+}
+
+// See slices.Map
+func Map[T any, V any](l *List[T], f func(T) V) *List[V] {
+	r0 := slices.Map(l.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.MapWithIndex
+func MapWithIndex[T any, V any](l *List[T], f func(T, int) V) *List[V] {
+	r0 := slices.MapWithIndex(l.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.TryMap
+func TryMap[T any, V any](l *List[T], f func(T) (V, error)) (*List[V], error) {
+	r0, r1 := slices.TryMap(l.slice, f)
+	if r1 != nil {
+		return nil, r1
+	}
+	return NewFromSlice(r0), nil
+}
+
+// See slices.TryMapWithIndex
+func TryMapWithIndex[T any, V any](l *List[T], f func(T, int) (V, error)) (*List[V], error) {
+	r0, r1 := slices.TryMapWithIndex(l.slice, f)
+	if r1 != nil {
+		return nil, r1
+	}
+	return NewFromSlice(r0), nil
+}
+
+// See slices.FlatMap
+func FlatMap[T any, V any](l *List[T], f func(T) []V) *List[V] {
+	r0 := slices.FlatMap(l.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.FlatMapWithIndex
+func FlatMapWithIndex[T any, V any](l *List[T], f func(T, int) []V) *List[V] {
+	r0 := slices.FlatMapWithIndex(l.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.FilterMap
+func FilterMap[T any, E any](l *List[T], test func(T) (E, bool)) *List[E] {
+	r0 := slices.FilterMap(l.slice, test)
+	return NewFromSlice(r0)
+}
+
+// See slices.FilterMapWithIndex
+func FilterMapWithIndex[T any, E any](l *List[T], test func(T, int) (E, bool)) *List[E] {
+	r0 := slices.FilterMapWithIndex(l.slice, test)
+	return NewFromSlice(r0)
+}
+
+// See slices.TryFilterMap
+func TryFilterMap[T any, E any](l *List[T], test func(T) (E, bool, error)) (*List[E], error) {
+	r0, r1 := slices.TryFilterMap(l.slice, test)
+	if r1 != nil {
+		return nil, r1
+	}
+	return NewFromSlice(r0), nil
+}
+
+// See slices.TryFilterMapWithIndex
+func TryFilterMapWithIndex[T any, E any](l *List[T], test func(T, int) (E, bool, error)) (*List[E], error) {
+	r0, r1 := slices.TryFilterMapWithIndex(l.slice, test)
+	if r1 != nil {
+		return nil, r1
+	}
+	return NewFromSlice(r0), nil
+}
+
+// See slices.First
+func (l *List[T]) First() (T, bool) {
+	return slices.First(l.slice)
+}
+
+// See slices.Last
+func (l *List[T]) Last() (T, bool) {
+	return slices.Last(l.slice)
+}
+
+// See slices.Zip
+func Zip[A any, B any, V any](l *List[A], b *List[B], f func(A, B) V) *List[V] {
+	r0 := slices.Zip(l.slice, b.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.Reduce
+func (l *List[T]) Reduce(f func(T, T) T) (T, bool) {
+	return slices.Reduce(l.slice, f)
+}
+
+// See slices.ReduceRight
+func (l *List[T]) ReduceRight(f func(T, T) T) (T, bool) {
+	return slices.ReduceRight(l.slice, f)
+}
+
+// See slices.TryReduce
+func (l *List[T]) TryReduce(f func(T, T) (T, error)) (T, bool, error) {
+	return slices.TryReduce(l.slice, f)
+}
+
+// See slices.Fold
+func Fold[T any, V any](l *List[T], initial V, f func(V, T) V) V {
+	return slices.Fold(l.slice, initial, f)
+}
+
+// See slices.TryFold
+func TryFold[T any, V any](l *List[T], initial V, f func(V, T) (V, error)) (V, error) {
+	return slices.TryFold(l.slice, initial, f)
+}
+
+// See slices.Scan
+func Scan[T any, V any](l *List[T], initial V, f func(V, T) V) *List[V] {
+	r0 := slices.Scan(l.slice, initial, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.MaxBy
+func MaxBy[T any, V constraints.Ordered](l *List[T], f func(T) V) V {
+	return slices.MaxBy(l.slice, f)
+}
+
+// See slices.MinBy
+func MinBy[T any, V constraints.Ordered](l *List[T], f func(T) V) V {
+	return slices.MinBy(l.slice, f)
+}
+
+// See slices.MaxElemBy
+func MaxElemBy[T any, V constraints.Ordered](l *List[T], f func(T) V) (T, int, bool) {
+	return slices.MaxElemBy(l.slice, f)
+}
+
+// See slices.MinElemBy
+func MinElemBy[T any, V constraints.Ordered](l *List[T], f func(T) V) (T, int, bool) {
+	return slices.MinElemBy(l.slice, f)
+}
+
+// See slices.MinMaxBy
+func MinMaxBy[T any, V constraints.Ordered](l *List[T], f func(T) V) (T, T, bool) {
+	return slices.MinMaxBy(l.slice, f)
+}
+
+// See slices.ArgMax
+func (l *OrderedList[T]) ArgMax() int {
+	return slices.ArgMax(l.slice)
+}
+
+// See slices.ArgMin
+func (l *OrderedList[T]) ArgMin() int {
+	return slices.ArgMin(l.slice)
+}
+
+// See slices.Intersect
+func (l *ComparableList[T]) Intersect(b *List[T]) *ComparableList[T] {
+	r0 := slices.Intersect(l.slice, b.slice)
+	return NewComparableFromSlice(r0)
+}
+
+// See slices.Difference
+func (l *ComparableList[T]) Difference(b *List[T]) *ComparableList[T] {
+	r0 := slices.Difference(l.slice, b.slice)
+	return NewComparableFromSlice(r0)
+}
+
+// See slices.Union
+func (l *ComparableList[T]) Union(b *List[T]) *ComparableList[T] {
+	r0 := slices.Union(l.slice, b.slice)
+	return NewComparableFromSlice(r0)
+}
+
+// See slices.SymmetricDifference
+func (l *ComparableList[T]) SymmetricDifference(b *List[T]) *ComparableList[T] {
+	r0 := slices.SymmetricDifference(l.slice, b.slice)
+	return NewComparableFromSlice(r0)
+}
+
+// See slices.IntersectBy
+func IntersectBy[T any, K comparable](l *List[T], b *List[T], f func(T) K) *List[T] {
+	r0 := slices.IntersectBy(l.slice, b.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.DifferenceBy
+func DifferenceBy[T any, K comparable](l *List[T], b *List[T], f func(T) K) *List[T] {
+	r0 := slices.DifferenceBy(l.slice, b.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.UnionBy
+func UnionBy[T any, K comparable](l *List[T], b *List[T], f func(T) K) *List[T] {
+	r0 := slices.UnionBy(l.slice, b.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.SymmetricDifferenceBy
+func SymmetricDifferenceBy[T any, K comparable](l *List[T], b *List[T], f func(T) K) *List[T] {
+	r0 := slices.SymmetricDifferenceBy(l.slice, b.slice, f)
+	return NewFromSlice(r0)
+}
+
+// See slices.IntersectSorted
+func (l *OrderedList[T]) IntersectSorted(b *List[T]) *OrderedList[T] {
+	r0 := slices.IntersectSorted(l.slice, b.slice)
+	return NewOrderedFromSlice(r0)
+}
+
+// See slices.DifferenceSorted
+func (l *OrderedList[T]) DifferenceSorted(b *List[T]) *OrderedList[T] {
+	r0 := slices.DifferenceSorted(l.slice, b.slice)
+	return NewOrderedFromSlice(r0)
+}
+
+// See slices.UnionSorted
+func (l *OrderedList[T]) UnionSorted(b *List[T]) *OrderedList[T] {
+	r0 := slices.UnionSorted(l.slice, b.slice)
+	return NewOrderedFromSlice(r0)
+}
+
+// See slices.SymmetricDifferenceSorted
+func (l *OrderedList[T]) SymmetricDifferenceSorted(b *List[T]) *OrderedList[T] {
+	r0 := slices.SymmetricDifferenceSorted(l.slice, b.slice)
+	return NewOrderedFromSlice(r0)
+}
+
+// See slices.TopK
+func (l *List[T]) TopK(k int, cmp func(a T, b T) int) *List[T] {
+	r0 := slices.TopK(l.slice, k, cmp)
+	return NewFromSlice(r0)
+}
+
+// See slices.BottomK
+func (l *List[T]) BottomK(k int, cmp func(a T, b T) int) *List[T] {
+	r0 := slices.BottomK(l.slice, k, cmp)
+	return NewFromSlice(r0)
+}
+
+// See slices.PartialSort
+func (l *List[T]) PartialSort(k int, cmp func(a T, b T) int) {
+	l.prepareForMutation()
+	slices.PartialSort(l.slice, k, cmp)
+}
+
+// See slices.NthElement
+func (l *List[T]) NthElement(n int, cmp func(a T, b T) int) {
+	l.prepareForMutation()
+	slices.NthElement(l.slice, n, cmp)
+}
+
+// See slices.FindMap
+func FindMap[T any, V any](l *List[T], f func(T) (V, bool)) (V, bool) {
+	return slices.FindMap(l.slice, f)
+}
+
+// See slices.Sum
+func Sum[T constraints.Integer | constraints.Float](l *List[T]) T {
+	return slices.Sum(l.slice)
+}
+
+// See slices.SumBy
+func SumBy[T any, V constraints.Integer | constraints.Float](l *List[T], f func(T) V) V {
+	return slices.SumBy(l.slice, f)
+}
+
+// See slices.SortBy
+func SortBy[T any, K cmp.Ordered](l *List[T], f func(T) K) {
+	l.prepareForMutation()
+	slices.SortBy(l.slice, f)
+}
+
+// See slices.SortStableBy
+func SortStableBy[T any, K cmp.Ordered](l *List[T], f func(T) K) {
+	l.prepareForMutation()
+	slices.SortStableBy(l.slice, f)
+}
@@ -0,0 +1,62 @@
+package maps
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+// One entry per function in the official maps package which we forward to.
+// Each fails to compile if our forwarder's signature differs from the official
+// one.
+var forwarders = map[string]func(){
+	"All": func() {
+		testutils.SameSignature(All[map[string]int, string, int], maps.All[map[string]int, string, int])
+	},
+	"Clone": func() {
+		testutils.SameSignature(Clone[map[string]int, string, int], maps.Clone[map[string]int, string, int])
+	},
+	"Collect": func() { testutils.SameSignature(Collect[string, int], maps.Collect[string, int]) },
+	"Copy": func() {
+		testutils.SameSignature(Copy[map[string]int, map[string]int, string, int], maps.Copy[map[string]int, map[string]int, string, int])
+	},
+	"DeleteFunc": func() {
+		testutils.SameSignature(DeleteFunc[map[string]int, string, int], maps.DeleteFunc[map[string]int, string, int])
+	},
+	"Equal": func() {
+		testutils.SameSignature(Equal[map[string]int, map[string]int, string, int], maps.Equal[map[string]int, map[string]int, string, int])
+	},
+	"EqualFunc": func() {
+		testutils.SameSignature(EqualFunc[map[string]int, map[string]bool, string, int, bool], maps.EqualFunc[map[string]int, map[string]bool, string, int, bool])
+	},
+	"Insert": func() {
+		testutils.SameSignature(Insert[map[string]int, string, int], maps.Insert[map[string]int, string, int])
+	},
+}
+
+// Official functions whose name we already used for something different
+// before the official package existed, along with the difference
+var differences = map[string]string{
+	"Keys":   "returns a slice rather than an iterator; use the standard library's maps.Keys for an iterator",
+	"Values": "returns a slice rather than an iterator; use the standard library's maps.Values for an iterator",
+}
+
+// Ensures that whenever Go adds a function to the official maps package, a
+// forwarder is added here too.
+func TestForwarders(t *testing.T) {
+	for _, name := range testutils.StdlibFunctions(t, "maps") {
+		_, forwarded := forwarders[name]
+		_, different := differences[name]
+		if !forwarded && !different {
+			t.Errorf("maps.%s has no forwarder", name)
+		}
+	}
+}
+
+func TestIteratorForwarders(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	copied := Collect(All(m))
+	Insert(copied, All(map[string]int{"c": 3}))
+	testutils.ExpectMap(t, map[string]int{"a": 1, "b": 2, "c": 3}, copied)
+}
@@ -1,5 +1,13 @@
 package maps
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Returns the keys in no particular order. (The official Keys returns an
+// iterator instead.)
 func Keys[Key comparable, Value any](m map[Key]Value) []Key {
 	keys := make([]Key, 0, len(m))
 	for key := range m {
@@ -8,6 +16,8 @@ func Keys[Key comparable, Value any](m map[Key]Value) []Key {
 	return keys
 }
 
+// Returns the values in no particular order. (The official Values returns an
+// iterator instead.)
 func Values[Key comparable, Value any](m map[Key]Value) []Value {
 	values := make([]Value, 0, len(m))
 	for _, value := range m {
@@ -34,6 +44,66 @@ func TransformKeys[Key comparable, Value any, NewKey comparable](m map[Key]Value
 	return output
 }
 
+// Like TransformKeys but when two keys transform to the same new key, resolve
+// is called to decide the value to keep. Because map iteration order is random,
+// which value is 'existing' and which is 'incoming' is arbitrary, so resolve
+// should not depend on the order of its arguments.
+func TransformKeysWithResolver[Key comparable, Value any, NewKey comparable](
+	m map[Key]Value,
+	fn func(Key) NewKey,
+	resolve func(newKey NewKey, existing Value, incoming Value) Value,
+) map[NewKey]Value {
+	output := make(map[NewKey]Value, len(m))
+	for key, value := range m {
+		newKey := fn(key)
+		if existing, ok := output[newKey]; ok {
+			value = resolve(newKey, existing, value)
+		}
+		output[newKey] = value
+	}
+	return output
+}
+
+// Like TransformKeys but returns a *KeyCollisionError listing every collision
+// if two or more keys transform to the same new key.
+func TransformKeysStrict[Key comparable, Value any, NewKey comparable](
+	m map[Key]Value, fn func(Key) NewKey,
+) (map[NewKey]Value, error) {
+	output := make(map[NewKey]Value, len(m))
+	sourceKeys := make(map[NewKey][]Key, len(m))
+	for key, value := range m {
+		newKey := fn(key)
+		sourceKeys[newKey] = append(sourceKeys[newKey], key)
+		output[newKey] = value
+	}
+
+	collisions := Filter(sourceKeys, func(_ NewKey, keys []Key) bool { return len(keys) > 1 })
+	if len(collisions) > 0 {
+		return nil, &KeyCollisionError[Key, NewKey]{Collisions: collisions}
+	}
+	return output, nil
+}
+
+// Returned when multiple keys of a map transform to the same new key.
+type KeyCollisionError[Key comparable, NewKey comparable] struct {
+	// Maps each new key to the source keys which collided on it.
+	Collisions map[NewKey][]Key
+}
+
+func (e *KeyCollisionError[Key, NewKey]) Error() string {
+	// sorting on the formatted keys so that the message is deterministic
+	descriptions := MapToSlice(e.Collisions, func(newKey NewKey, keys []Key) string {
+		formattedKeys := make([]string, 0, len(keys))
+		for _, key := range keys {
+			formattedKeys = append(formattedKeys, fmt.Sprint(key))
+		}
+		sort.Strings(formattedKeys)
+		return fmt.Sprintf("%s -> %v", strings.Join(formattedKeys, ", "), newKey)
+	})
+	sort.Strings(descriptions)
+	return "key collisions: " + strings.Join(descriptions, "; ")
+}
+
 func MapToSlice[Key comparable, Value any, Mapped any](m map[Key]Value, f func(Key, Value) Mapped) []Mapped {
 	output := make([]Mapped, 0, len(m))
 	for key, value := range m {
@@ -51,3 +121,78 @@ func Filter[Key comparable, Value any](m map[Key]Value, f func(Key, Value) bool)
 	}
 	return output
 }
+
+// Like TransformValues but stops at the first error, which is wrapped with the
+// offending key.
+func TryTransformValues[Key comparable, Value any, NewValue any](
+	m map[Key]Value, fn func(Value) (NewValue, error),
+) (map[Key]NewValue, error) {
+	output := make(map[Key]NewValue, len(m))
+	for key, value := range m {
+		newValue, err := fn(value)
+		if err != nil {
+			return nil, fmt.Errorf("key %v: %w", key, err)
+		}
+		output[key] = newValue
+	}
+	return output, nil
+}
+
+// Like TransformKeys but stops at the first error, which is wrapped with the
+// offending key. Unlike TransformKeys, it is an error for two keys to transform
+// to the same new key: as with TransformKeysStrict, a *KeyCollisionError lists
+// every collision.
+func TryTransformKeys[Key comparable, Value any, NewKey comparable](
+	m map[Key]Value, fn func(Key) (NewKey, error),
+) (map[NewKey]Value, error) {
+	output := make(map[NewKey]Value, len(m))
+	sourceKeys := make(map[NewKey][]Key, len(m))
+	for key, value := range m {
+		newKey, err := fn(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %v: %w", key, err)
+		}
+		sourceKeys[newKey] = append(sourceKeys[newKey], key)
+		output[newKey] = value
+	}
+
+	collisions := Filter(sourceKeys, func(_ NewKey, keys []Key) bool { return len(keys) > 1 })
+	if len(collisions) > 0 {
+		return nil, &KeyCollisionError[Key, NewKey]{Collisions: collisions}
+	}
+	return output, nil
+}
+
+// Like MapToSlice but stops at the first error, which is wrapped with the
+// offending key.
+func TryMapToSlice[Key comparable, Value any, Mapped any](
+	m map[Key]Value, f func(Key, Value) (Mapped, error),
+) ([]Mapped, error) {
+	output := make([]Mapped, 0, len(m))
+	for key, value := range m {
+		mapped, err := f(key, value)
+		if err != nil {
+			return nil, fmt.Errorf("key %v: %w", key, err)
+		}
+		output = append(output, mapped)
+	}
+	return output, nil
+}
+
+// Like Filter but stops at the first error, which is wrapped with the
+// offending key.
+func TryFilter[Key comparable, Value any](
+	m map[Key]Value, f func(Key, Value) (bool, error),
+) (map[Key]Value, error) {
+	output := map[Key]Value{}
+	for key, value := range m {
+		ok, err := f(key, value)
+		if err != nil {
+			return nil, fmt.Errorf("key %v: %w", key, err)
+		}
+		if ok {
+			output[key] = value
+		}
+	}
+	return output, nil
+}
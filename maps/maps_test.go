@@ -1,7 +1,9 @@
 package maps
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/jesseduffield/generics/internal/testutils"
@@ -55,6 +57,45 @@ func TestTransformKeys(t *testing.T) {
 	}
 }
 
+func TestTransformKeysWithResolver(t *testing.T) {
+	sum := func(_ string, existing int, incoming int) int { return existing + incoming }
+
+	tests := []struct {
+		hashMap  map[string]int
+		expected map[string]int
+	}{
+		{map[string]int{}, map[string]int{}},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 2}},
+		{map[string]int{"a": 1, "A": 2, "b": 3}, map[string]int{"a": 3, "b": 3}},
+	}
+	for _, test := range tests {
+		testutils.ExpectMap(t, test.expected, TransformKeysWithResolver(test.hashMap, strings.ToLower, sum))
+	}
+}
+
+func TestTransformKeysStrict(t *testing.T) {
+	result, err := TransformKeysStrict(map[string]int{"a": 1, "B": 2}, strings.ToLower)
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectMap(t, map[string]int{"a": 1, "b": 2}, result)
+
+	result, err = TransformKeysStrict(
+		map[string]int{"a": 1, "A": 2, "b": 3, "B": 4, "c": 5},
+		strings.ToLower,
+	)
+	testutils.ExpectError(t, err, "key collisions: A, a -> a; B, b -> b")
+	if result != nil {
+		t.Errorf("Expected nil map, got %v", result)
+	}
+
+	var collisionErr *KeyCollisionError[string, string]
+	if !errors.As(err, &collisionErr) {
+		t.Fatalf("Expected *KeyCollisionError, got %T", err)
+	}
+	if len(collisionErr.Collisions) != 2 || len(collisionErr.Collisions["a"]) != 2 {
+		t.Errorf("Unexpected collisions %v", collisionErr.Collisions)
+	}
+}
+
 func TestTransformValues(t *testing.T) {
 	tests := []struct {
 		hashMap   map[string]int
@@ -130,3 +171,85 @@ func TestFilter(t *testing.T) {
 		testutils.ExpectMap(t, test.expected, Filter(test.hashMap, test.f))
 	}
 }
+
+func TestTryTransformValues(t *testing.T) {
+	double := func(i int) (int64, error) {
+		if i < 0 {
+			return 0, errors.New("negative")
+		}
+		return 2 * (int64)(i), nil
+	}
+
+	result, err := TryTransformValues(map[string]int{"a": 1, "b": 2}, double)
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectMap(t, map[string]int64{"a": 2, "b": 4}, result)
+
+	result, err = TryTransformValues(map[string]int{"a": 1, "b": -2}, double)
+	testutils.ExpectError(t, err, "key b: negative")
+	if result != nil {
+		t.Errorf("Expected nil map, got %v", result)
+	}
+}
+
+func TestTryTransformKeys(t *testing.T) {
+	result, err := TryTransformKeys(map[int]string{1: "a", 2: "b"}, func(i int) (int64, error) {
+		return 2 * (int64)(i), nil
+	})
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectMap(t, map[int64]string{2: "a", 4: "b"}, result)
+
+	_, err = TryTransformKeys(map[int]string{1: "a", -2: "b"}, func(i int) (int64, error) {
+		if i < 0 {
+			return 0, errors.New("negative")
+		}
+		return (int64)(i), nil
+	})
+	testutils.ExpectError(t, err, "key -2: negative")
+
+	_, err = TryTransformKeys(map[int]string{1: "a", -1: "b"}, func(i int) (int64, error) {
+		if i < 0 {
+			return (int64)(-i), nil
+		}
+		return (int64)(i), nil
+	})
+	testutils.ExpectError(t, err, "key collisions: -1, 1 -> 1")
+	var collisionErr *KeyCollisionError[int, int64]
+	if !errors.As(err, &collisionErr) {
+		t.Fatalf("Expected *KeyCollisionError, got %T", err)
+	}
+	if len(collisionErr.Collisions) != 1 || len(collisionErr.Collisions[1]) != 2 {
+		t.Errorf("Unexpected collisions %v", collisionErr.Collisions)
+	}
+}
+
+func TestTryMapToSlice(t *testing.T) {
+	f := func(k int64, v int) (string, error) {
+		if v < 0 {
+			return "", errors.New("negative")
+		}
+		return fmt.Sprintf("%d:%d", k, v), nil
+	}
+
+	result, err := TryMapToSlice(map[int64]int{2: 5}, f)
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectSlice(t, []string{"2:5"}, result)
+
+	_, err = TryMapToSlice(map[int64]int{2: 5, 3: -4}, f)
+	testutils.ExpectError(t, err, "key 3: negative")
+}
+
+func TestTryFilter(t *testing.T) {
+	f := func(k int64, v int) (bool, error) {
+		if k == 0 {
+			return false, errors.New("zero key")
+		}
+		return int(k)+v > 0, nil
+	}
+
+	result, err := TryFilter(map[int64]int{2: 5, 3: -4}, f)
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectMap(t, map[int64]int{2: 5}, result)
+
+	_, err = TryFilter(map[int64]int{2: 5, 0: 1}, f)
+	testutils.ExpectError(t, err, "key 0: zero key")
+}
@@ -0,0 +1,64 @@
+package numeric
+
+import (
+	"math"
+
+	"github.com/jesseduffield/generics/slices"
+	"golang.org/x/exp/constraints"
+)
+
+// Number is satisfied by the built-in integer and float types. Unlike
+// constraints.Ordered it excludes strings, so functions here can't be used to
+// concatenate by accident.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Adds up the elements. For floats this can accumulate rounding error over
+// long slices; see KahanSum.
+func Sum[T Number](slice []T) T {
+	return slices.Sum(slice)
+}
+
+// Adds up the values produced by f for each element
+func SumBy[T any, V Number](slice []T, f func(T) V) V {
+	return slices.SumBy(slice, f)
+}
+
+// Adds up the elements using compensated (Kahan-Babuska) summation, which keeps
+// the rounding error independent of the slice's length. E.g. summing
+// []float64{1, 1e100, 1, -1e100} gives 2 where Sum gives 0. Infinities and
+// overflow give an infinite result, as with Sum.
+func KahanSum[T constraints.Float](slice []T) T {
+	var sum, compensation T
+	for _, value := range slice {
+		total := sum + value
+		// recovering the low-order bits lost when adding the smaller of the two.
+		// An infinite total has none, and Inf-Inf would make the compensation NaN.
+		switch {
+		case math.IsInf(float64(total), 0):
+		case abs(sum) >= abs(value):
+			compensation += (sum - total) + value
+		default:
+			compensation += (value - total) + sum
+		}
+		sum = total
+	}
+	return sum + compensation
+}
+
+// Multiplies the elements together. The product of an empty slice is 1.
+func Product[T Number](slice []T) T {
+	product := T(1)
+	for _, value := range slice {
+		product *= value
+	}
+	return product
+}
+
+func abs[T constraints.Float](value T) T {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,67 @@
+package numeric
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	if result := Sum([]int{1, 2, 3}); result != 6 {
+		t.Errorf("Sum() = %v, expected %v", result, 6)
+	}
+	if result := Sum([]uint8{}); result != 0 {
+		t.Errorf("Sum() of empty slice = %v, expected %v", result, 0)
+	}
+	if result := SumBy([]string{"a", "bc"}, func(value string) float64 { return float64(len(value)) / 2 }); result != 1.5 {
+		t.Errorf("SumBy() = %v, expected %v", result, 1.5)
+	}
+}
+
+func TestKahanSum(t *testing.T) {
+	tests := []struct {
+		slice    []float64
+		expected float64
+	}{
+		{[]float64{}, 0},
+		{[]float64{1.5, 2.5}, 4},
+		{[]float64{1, 1e100, 1, -1e100}, 2},
+		{[]float64{math.Inf(1)}, math.Inf(1)},
+		{[]float64{1, math.Inf(-1), 2}, math.Inf(-1)},
+		{[]float64{math.MaxFloat64, math.MaxFloat64}, math.Inf(1)},
+		{[]float64{-math.MaxFloat64, -math.MaxFloat64, 1}, math.Inf(-1)},
+	}
+	for _, test := range tests {
+		if result := KahanSum(test.slice); result != test.expected {
+			t.Errorf("KahanSum(%v) = %v, expected %v", test.slice, result, test.expected)
+		}
+	}
+
+	// naive summation drifts when adding many small values
+	tenths := make([]float64, 1000)
+	for i := range tenths {
+		tenths[i] = 0.1
+	}
+	if result := KahanSum(tenths); result != 100 {
+		t.Errorf("KahanSum() of 1000 tenths = %v, expected %v", result, 100)
+	}
+	if Sum(tenths) == 100 {
+		t.Errorf("expected naive Sum() of 1000 tenths to be inexact")
+	}
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		slice    []int
+		expected int
+	}{
+		{[]int{}, 1},
+		{[]int{5}, 5},
+		{[]int{2, 3, 4}, 24},
+		{[]int{2, 0, 4}, 0},
+	}
+	for _, test := range tests {
+		if result := Product(test.slice); result != test.expected {
+			t.Errorf("Product(%v) = %v, expected %v", test.slice, result, test.expected)
+		}
+	}
+}
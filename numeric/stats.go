@@ -0,0 +1,186 @@
+package numeric
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jesseduffield/generics/slices"
+)
+
+// The statistics below are computed as float64 regardless of the element type,
+// and return false for an empty slice rather than NaN.
+
+// Returns the arithmetic mean, using compensated summation
+func Mean[T Number](slice []T) (float64, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+	return KahanSum(toFloats(slice)) / float64(len(slice)), true
+}
+
+// Returns the middle element of the sorted slice, or the mean of the two middle
+// elements if the length is even. The slice itself is left untouched.
+func Median[T Number](slice []T) (float64, bool) {
+	return Percentile(slice, 50)
+}
+
+// Returns the most frequent element. If several elements are equally frequent,
+// the one which appears first in the slice is returned.
+func Mode[T Number](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	counts := map[T]int{}
+	for _, value := range slice {
+		counts[value]++
+	}
+	mode := slice[0]
+	for _, value := range slice {
+		if counts[value] > counts[mode] {
+			mode = value
+		}
+	}
+	return mode, true
+}
+
+// Returns the population variance, i.e. the mean squared distance from the
+// mean. For the variance of a sample, see SampleVariance.
+func Variance[T Number](slice []T) (float64, bool) {
+	sumOfSquares, ok := sumOfSquaredDeviations(slice)
+	if !ok {
+		return 0, false
+	}
+	return sumOfSquares / float64(len(slice)), true
+}
+
+// Returns the variance of a sample drawn from a larger population, which
+// divides by n-1 rather than n (Bessel's correction). Returns false if the slice
+// has fewer than two elements.
+func SampleVariance[T Number](slice []T) (float64, bool) {
+	if len(slice) < 2 {
+		return 0, false
+	}
+	sumOfSquares, _ := sumOfSquaredDeviations(slice)
+	return sumOfSquares / float64(len(slice)-1), true
+}
+
+// Returns the population standard deviation (see Variance)
+func StdDev[T Number](slice []T) (float64, bool) {
+	variance, ok := Variance(slice)
+	return math.Sqrt(variance), ok
+}
+
+// Returns the sample standard deviation (see SampleVariance)
+func SampleStdDev[T Number](slice []T) (float64, bool) {
+	variance, ok := SampleVariance(slice)
+	return math.Sqrt(variance), ok
+}
+
+// Uses Welford's algorithm, which avoids the cancellation error of subtracting
+// the squared mean from the mean of the squares.
+func sumOfSquaredDeviations[T Number](slice []T) (float64, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+	mean, sumOfSquares := 0.0, 0.0
+	for i, value := range slice {
+		x := float64(value)
+		delta := x - mean
+		mean += delta / float64(i+1)
+		sumOfSquares += delta * (x - mean)
+	}
+	return sumOfSquares, true
+}
+
+// Returns the value below which the given percentage of the elements fall,
+// interpolating linearly between the closest elements (like NumPy's default
+// method). Percentile(slice, 50) is the median. The slice itself is left
+// untouched. Panics if p is not between 0 and 100.
+func Percentile[T Number](slice []T, p float64) (float64, bool) {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		panic(fmt.Sprintf("numeric: percentile %v is not between 0 and 100", p))
+	}
+	if len(slice) == 0 {
+		return 0, false
+	}
+
+	sorted := slices.Clone(slice)
+	slices.Sort(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	if lower == len(sorted)-1 {
+		return float64(sorted[lower]), true
+	}
+	fraction := rank - float64(lower)
+	return float64(sorted[lower])*(1-fraction) + float64(sorted[lower+1])*fraction, true
+}
+
+// A Histogram bin, counting the elements from Low up to but not including
+// High. The last bin also includes High.
+type Bin struct {
+	Low   float64
+	High  float64
+	Count int
+}
+
+// Divides the range from the least to the greatest element into the given
+// number of equal-width bins, and counts the elements falling into each.
+// NaNs and infinities are skipped, as they have no place in any bin. Returns
+// no bins if there are no other elements. Panics if bins is not positive.
+func Histogram[T Number](slice []T, bins int) []Bin {
+	if bins <= 0 {
+		panic("numeric: Histogram needs a positive number of bins")
+	}
+	values := slices.Filter(toFloats(slice), func(value float64) bool {
+		return !math.IsNaN(value) && !math.IsInf(value, 0)
+	})
+	if len(values) == 0 {
+		return []Bin{}
+	}
+
+	min, max := slices.Min(values), slices.Max(values)
+	// dividing before subtracting, since max-min can overflow even though both
+	// are finite
+	width := max/float64(bins) - min/float64(bins)
+	result := make([]Bin, bins)
+	for i := range result {
+		result[i].Low = histogramEdge(min, max, i, bins)
+		result[i].High = histogramEdge(min, max, i+1, bins)
+	}
+
+	for _, value := range values {
+		index := bins - 1
+		if width > 0 {
+			position := value/width - min/width
+			if position < float64(bins-1) {
+				index = int(position)
+			}
+			if index < 0 {
+				index = 0
+			}
+		}
+		result[index].Count++
+	}
+	return result
+}
+
+// Returns the boundary between the bins either side of the given index,
+// weighting min and max rather than adding multiples of the width to min,
+// which could overflow. The outer edges are exactly min and max.
+func histogramEdge(min float64, max float64, index int, bins int) float64 {
+	switch index {
+	case 0:
+		return min
+	case bins:
+		return max
+	}
+	fraction := float64(index) / float64(bins)
+	return min*(1-fraction) + max*fraction
+}
+
+func toFloats[T Number](slice []T) []float64 {
+	return slices.Map(slice, func(value T) float64 { return float64(value) })
+}
@@ -0,0 +1,179 @@
+package numeric
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestStatistics(t *testing.T) {
+	type result struct {
+		value float64
+		ok    bool
+	}
+	wrap := func(value float64, ok bool) result { return result{value, ok} }
+
+	tests := []struct {
+		slice            []int
+		mean             result
+		median           result
+		variance         result
+		sampleVariance   result
+		stdDev           result
+		sampleStdDev     result
+		ninetiethPercent result
+	}{
+		{
+			[]int{},
+			result{0, false}, result{0, false}, result{0, false}, result{0, false}, result{0, false}, result{0, false}, result{0, false},
+		},
+		{
+			[]int{3},
+			result{3, true}, result{3, true}, result{0, true}, result{0, false}, result{0, true}, result{0, false}, result{3, true},
+		},
+		{
+			[]int{2, 4, 4, 4, 5, 5, 7, 9},
+			result{5, true}, result{4.5, true}, result{4, true}, result{32.0 / 7, true}, result{2, true}, result{math.Sqrt(32.0 / 7), true}, result{7.6, true},
+		},
+	}
+	for _, test := range tests {
+		actual := []result{
+			wrap(Mean(test.slice)),
+			wrap(Median(test.slice)),
+			wrap(Variance(test.slice)),
+			wrap(SampleVariance(test.slice)),
+			wrap(StdDev(test.slice)),
+			wrap(SampleStdDev(test.slice)),
+			wrap(Percentile(test.slice, 90)),
+		}
+		expected := []result{test.mean, test.median, test.variance, test.sampleVariance, test.stdDev, test.sampleStdDev, test.ninetiethPercent}
+		names := []string{"Mean", "Median", "Variance", "SampleVariance", "StdDev", "SampleStdDev", "Percentile"}
+		for i := range actual {
+			if math.Abs(actual[i].value-expected[i].value) > 1e-9 || actual[i].ok != expected[i].ok {
+				t.Errorf("%v(%v) = %v, expected %v", names[i], test.slice, actual[i], expected[i])
+			}
+		}
+	}
+
+	infiniteMeans := []struct {
+		slice    []float64
+		expected float64
+	}{
+		{[]float64{1, math.Inf(1)}, math.Inf(1)},
+		{[]float64{math.Inf(-1), 1}, math.Inf(-1)},
+		{[]float64{math.MaxFloat64, math.MaxFloat64}, math.Inf(1)},
+	}
+	for _, test := range infiniteMeans {
+		if result, ok := Mean(test.slice); result != test.expected || !ok {
+			t.Errorf("Mean(%v) = %v, %v, expected %v, %v", test.slice, result, ok, test.expected, true)
+		}
+	}
+}
+
+func TestMedianLeavesSliceUntouched(t *testing.T) {
+	slice := []float64{3, 1, 2}
+	if median, _ := Median(slice); median != 2 {
+		t.Errorf("Median() = %v, expected %v", median, 2)
+	}
+	testutils.ExpectSlice(t, []float64{3, 1, 2}, slice)
+}
+
+func TestPercentile(t *testing.T) {
+	slice := []int{10, 20, 30, 40}
+	tests := []struct {
+		p        float64
+		expected float64
+	}{
+		{0, 10},
+		{25, 17.5},
+		{50, 25},
+		{100, 40},
+	}
+	for _, test := range tests {
+		if result, _ := Percentile(slice, test.p); result != test.expected {
+			t.Errorf("Percentile(%v) = %v, expected %v", test.p, result, test.expected)
+		}
+	}
+
+	for _, p := range []float64{-1, 101, math.NaN()} {
+		func() {
+			defer testutils.ExpectPanic(t)
+			Percentile(slice, p)
+		}()
+	}
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		slice      []int
+		expected   int
+		expectedOK bool
+	}{
+		{[]int{}, 0, false},
+		{[]int{7}, 7, true},
+		{[]int{1, 2, 2, 3}, 2, true},
+		// ties go to the value appearing first
+		{[]int{3, 1, 1, 3}, 3, true},
+		{[]int{1, 2, 2, 1, 3, 3}, 1, true},
+	}
+	for _, test := range tests {
+		result, ok := Mode(test.slice)
+		if result != test.expected || ok != test.expectedOK {
+			t.Errorf("Mode(%v) = %v, %v, expected %v, %v", test.slice, result, ok, test.expected, test.expectedOK)
+		}
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	// a power of two, so that the bin edges are exact
+	big := math.Ldexp(1, 1023)
+
+	tests := []struct {
+		slice    []float64
+		bins     int
+		expected []Bin
+	}{
+		{[]float64{}, 3, []Bin{}},
+		{[]float64{5, 5}, 2, []Bin{{5, 5, 0}, {5, 5, 2}}},
+		{
+			[]float64{0, 1, 2, 3, 4, 5, 6},
+			3,
+			[]Bin{{0, 2, 2}, {2, 4, 2}, {4, 6, 3}},
+		},
+		{
+			[]float64{math.Inf(-1), 0, math.NaN(), 1, 2, 3, math.Inf(1)},
+			2,
+			[]Bin{{0, 1.5, 2}, {1.5, 3, 2}},
+		},
+		{[]float64{math.NaN(), math.Inf(1)}, 2, []Bin{}},
+		// the range overflows even though the values are finite
+		{
+			[]float64{-math.MaxFloat64, 0, math.MaxFloat64},
+			2,
+			[]Bin{{-math.MaxFloat64, 0, 1}, {0, math.MaxFloat64, 2}},
+		},
+		{
+			[]float64{-math.MaxFloat64, math.MaxFloat64},
+			1,
+			[]Bin{{-math.MaxFloat64, math.MaxFloat64, 2}},
+		},
+		{
+			[]float64{-big, big},
+			4,
+			[]Bin{{-big, -big / 2, 1}, {-big / 2, 0, 0}, {0, big / 2, 0}, {big / 2, big, 1}},
+		},
+	}
+	for _, test := range tests {
+		result := Histogram(test.slice, test.bins)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Histogram(%v, %v) = %v, expected %v", test.slice, test.bins, result, test.expected)
+		}
+	}
+
+	func() {
+		defer testutils.ExpectPanic(t)
+		Histogram([]int{1}, 0)
+	}()
+}
@@ -0,0 +1,153 @@
+package orderedmap
+
+import (
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+)
+
+// OrderedMap is a map which remembers the order in which keys were first
+// inserted. Setting an existing key updates its value without changing its
+// position.
+//
+// We wrap a third-party implementation so that its API can change without
+// affecting users of this package.
+//
+// The zero value is an empty map ready to use, so an OrderedMap can be held by
+// value in a struct.
+type OrderedMap[K comparable, V any] struct {
+	om *orderedmap.OrderedMap[K, V]
+}
+
+func New[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{om: orderedmap.New[K, V]()}
+}
+
+func NewWithCapacity[K comparable, V any](capacity int) *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{om: orderedmap.New[K, V](capacity)}
+}
+
+// Sets the value for the given key. If the key is new it is added as the
+// newest entry; otherwise its position is left untouched.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.lazyInit()
+	m.om.Set(key, value)
+}
+
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	m.lazyInit()
+	return m.om.Get(key)
+}
+
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	m.lazyInit()
+	_, ok := m.om.Get(key)
+	return ok
+}
+
+// Deletes the given key, returning whether it was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	m.lazyInit()
+	_, ok := m.om.Delete(key)
+	return ok
+}
+
+func (m *OrderedMap[K, V]) Len() int {
+	m.lazyInit()
+	return m.om.Len()
+}
+
+// Makes the given key the oldest entry. Returns false if the key is not present.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	m.lazyInit()
+	return m.om.MoveToFront(key) == nil
+}
+
+// Makes the given key the newest entry. Returns false if the key is not present.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	m.lazyInit()
+	return m.om.MoveToBack(key) == nil
+}
+
+// Returns an iterator over the entries from oldest to newest. The iterator
+// calls yield for each entry until yield returns false.
+func (m *OrderedMap[K, V]) FromOldest() func(yield func(K, V) bool) {
+	m.lazyInit()
+	return func(yield func(K, V) bool) {
+		for pair := m.om.Oldest(); pair != nil; pair = pair.Next() {
+			if !yield(pair.Key, pair.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the entries from newest to oldest. The iterator
+// calls yield for each entry until yield returns false.
+func (m *OrderedMap[K, V]) FromNewest() func(yield func(K, V) bool) {
+	m.lazyInit()
+	return func(yield func(K, V) bool) {
+		for pair := m.om.Newest(); pair != nil; pair = pair.Prev() {
+			if !yield(pair.Key, pair.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *OrderedMap[K, V]) KeysFromOldest() []K {
+	result := make([]K, 0, m.Len())
+	m.FromOldest()(func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+	return result
+}
+
+func (m *OrderedMap[K, V]) KeysFromNewest() []K {
+	result := make([]K, 0, m.Len())
+	m.FromNewest()(func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+	return result
+}
+
+func (m *OrderedMap[K, V]) ValuesFromOldest() []V {
+	result := make([]V, 0, m.Len())
+	m.FromOldest()(func(_ K, value V) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
+func (m *OrderedMap[K, V]) ValuesFromNewest() []V {
+	result := make([]V, 0, m.Len())
+	m.FromNewest()(func(_ K, value V) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
+// Marshals to a JSON object whose keys appear in insertion order. This has a
+// value receiver so that maps held by value in a struct are marshalled in order
+// too.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	if m.om == nil {
+		return []byte("{}"), nil
+	}
+	return m.om.MarshalJSON()
+}
+
+// Unmarshals a JSON object, adding its keys in the order they appear. This
+// also works on a zero-value OrderedMap so that it can be embedded in structs.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	m.lazyInit()
+	return m.om.UnmarshalJSON(data)
+}
+
+func (m *OrderedMap[K, V]) lazyInit() {
+	if m.om == nil {
+		m.om = orderedmap.New[K, V]()
+	}
+}
@@ -0,0 +1,155 @@
+package orderedmap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestSetGet(t *testing.T) {
+	m := New[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 3)
+
+	value, ok := m.Get("a")
+	if !ok || value != 3 {
+		t.Errorf("Get(a) = %v, %v, expected %v, %v", value, ok, 3, true)
+	}
+
+	value, ok = m.Get("c")
+	if ok || value != 0 {
+		t.Errorf("Get(c) = %v, %v, expected %v, %v", value, ok, 0, false)
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("Len() = %v, expected %v", m.Len(), 2)
+	}
+
+	// updating an existing key does not change its position
+	testutils.ExpectSlice(t, []string{"a", "b"}, m.KeysFromOldest())
+	testutils.ExpectSlice(t, []int{3, 2}, m.ValuesFromOldest())
+}
+
+func TestDelete(t *testing.T) {
+	m := New[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	if !m.Delete("a") {
+		t.Errorf("Delete(a) = false, expected true")
+	}
+	if m.Delete("a") {
+		t.Errorf("Delete(a) = true, expected false")
+	}
+	if m.Has("a") {
+		t.Errorf("Has(a) = true, expected false")
+	}
+	testutils.ExpectSlice(t, []string{"b"}, m.KeysFromOldest())
+}
+
+func TestMove(t *testing.T) {
+	m := New[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if !m.MoveToBack("a") {
+		t.Errorf("MoveToBack(a) = false, expected true")
+	}
+	testutils.ExpectSlice(t, []string{"b", "c", "a"}, m.KeysFromOldest())
+
+	if !m.MoveToFront("c") {
+		t.Errorf("MoveToFront(c) = false, expected true")
+	}
+	testutils.ExpectSlice(t, []string{"c", "b", "a"}, m.KeysFromOldest())
+
+	if m.MoveToFront("d") {
+		t.Errorf("MoveToFront(d) = true, expected false")
+	}
+}
+
+func TestIterators(t *testing.T) {
+	m := New[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	keys := []string{}
+	m.FromNewest()(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	testutils.ExpectSlice(t, []string{"c", "b", "a"}, keys)
+	testutils.ExpectSlice(t, []int{3, 2, 1}, m.ValuesFromNewest())
+
+	// stops early when yield returns false
+	keys = []string{}
+	m.FromOldest()(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return key != "b"
+	})
+	testutils.ExpectSlice(t, []string{"a", "b"}, keys)
+}
+
+func TestJSON(t *testing.T) {
+	m := New[string, int]()
+	m.Set("z", 1)
+	m.Set("a", 2)
+	m.Set("m", 3)
+
+	data, err := json.Marshal(m)
+	testutils.ExpectNilError(t, err)
+	if string(data) != `{"z":1,"a":2,"m":3}` {
+		t.Errorf("Marshal = %s, expected %s", data, `{"z":1,"a":2,"m":3}`)
+	}
+
+	var config struct {
+		Values OrderedMap[string, int]
+	}
+	err = json.Unmarshal([]byte(`{"Values":{"z":1,"a":2,"m":3}}`), &config)
+	testutils.ExpectNilError(t, err)
+	testutils.ExpectSlice(t, []string{"z", "a", "m"}, config.Values.KeysFromOldest())
+	testutils.ExpectSlice(t, []int{1, 2, 3}, config.Values.ValuesFromOldest())
+}
+
+func TestZeroValue(t *testing.T) {
+	var m OrderedMap[string, int]
+	if m.Len() != 0 || m.Has("a") || m.Delete("a") || m.MoveToFront("a") {
+		t.Errorf("unexpected contents in zero-value map")
+	}
+	m.Set("b", 1)
+	m.Set("a", 2)
+	value, ok := m.Get("a")
+	if !ok || value != 2 {
+		t.Errorf("Get(a) = %v, %v, expected %v, %v", value, ok, 2, true)
+	}
+	testutils.ExpectSlice(t, []string{"b", "a"}, m.KeysFromOldest())
+}
+
+func TestJSONByValue(t *testing.T) {
+	type config struct {
+		Values OrderedMap[string, int]
+	}
+
+	var empty config
+	data, err := json.Marshal(empty)
+	testutils.ExpectNilError(t, err)
+	if string(data) != `{"Values":{}}` {
+		t.Errorf("Marshal = %s, expected %s", data, `{"Values":{}}`)
+	}
+
+	var c config
+	c.Values.Set("z", 1)
+	c.Values.Set("a", 2)
+	data, err = json.Marshal(c)
+	testutils.ExpectNilError(t, err)
+	if string(data) != `{"Values":{"z":1,"a":2}}` {
+		t.Errorf("Marshal = %s, expected %s", data, `{"Values":{"z":1,"a":2}}`)
+	}
+
+	var roundTripped config
+	testutils.ExpectNilError(t, json.Unmarshal(data, &roundTripped))
+	testutils.ExpectSlice(t, []string{"z", "a"}, roundTripped.Values.KeysFromOldest())
+}
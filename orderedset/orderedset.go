@@ -1,26 +1,26 @@
 package orderedset
 
 import (
-	orderedmap "github.com/wk8/go-ordered-map/v2"
+	"github.com/jesseduffield/generics/orderedmap"
 )
 
 type OrderedSet[T comparable] struct {
-	om *orderedmap.OrderedMap[T, bool]
+	om *orderedmap.OrderedMap[T, struct{}]
 }
 
 func New[T comparable]() *OrderedSet[T] {
-	return &OrderedSet[T]{om: orderedmap.New[T, bool]()}
+	return &OrderedSet[T]{om: orderedmap.New[T, struct{}]()}
 }
 
 func NewFromSlice[T comparable](slice []T) *OrderedSet[T] {
-	result := &OrderedSet[T]{om: orderedmap.New[T, bool](len(slice))}
+	result := &OrderedSet[T]{om: orderedmap.NewWithCapacity[T, struct{}](len(slice))}
 	result.Add(slice...)
 	return result
 }
 
 func (os *OrderedSet[T]) Add(values ...T) {
 	for _, value := range values {
-		os.om.Set(value, true)
+		os.om.Set(value, struct{}{})
 	}
 }
 
@@ -35,7 +35,7 @@ func (os *OrderedSet[T]) RemoveSlice(slice []T) {
 }
 
 func (os *OrderedSet[T]) Includes(value T) bool {
-	return os.om.Value(value)
+	return os.om.Has(value)
 }
 
 func (os *OrderedSet[T]) Len() int {
@@ -43,23 +43,9 @@ func (os *OrderedSet[T]) Len() int {
 }
 
 func (os *OrderedSet[T]) ToSliceFromOldest() []T {
-	// TODO: can be simplified to
-	//   return os.om.KeysFromOldest()
-	// when we update to a newer version of go-ordered-map
-	result := make([]T, 0, os.Len())
-	for pair := os.om.Oldest(); pair != nil; pair = pair.Next() {
-		result = append(result, pair.Key)
-	}
-	return result
+	return os.om.KeysFromOldest()
 }
 
 func (os *OrderedSet[T]) ToSliceFromNewest() []T {
-	// TODO: can be simplified to
-	//   return os.om.KeysFromNewest()
-	// when we update to a newer version of go-ordered-map
-	result := make([]T, 0, os.Len())
-	for pair := os.om.Newest(); pair != nil; pair = pair.Prev() {
-		result = append(result, pair.Key)
-	}
-	return result
+	return os.om.KeysFromNewest()
 }
@@ -0,0 +1,236 @@
+package persistent
+
+import "fmt"
+
+// Vector is an immutable list. Methods which would modify it instead return a
+// new Vector, sharing most of its structure with the original, so keeping old
+// versions around (e.g. for undo history) is cheap.
+//
+// Elements are stored in a 32-way trie, so Get, Set and Append are O(log32 n),
+// which is effectively constant for any realistic size. The zero value is an
+// empty vector ready to use.
+type Vector[T any] struct {
+	root  *node[T]
+	tail  []T
+	shift uint
+	// number of elements in the trie and tail combined
+	size int
+
+	// The vector may be a view onto a range of the elements, as returned by
+	// Slice. For a vector which isn't a view, start is 0 and end is size.
+	start int
+	end   int
+}
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+// Branch nodes have children; leaf nodes have values.
+type node[T any] struct {
+	children []*node[T]
+	values   []T
+}
+
+func New[T any]() Vector[T] {
+	return Vector[T]{}
+}
+
+func NewFromSlice[T any](slice []T) Vector[T] {
+	result := New[T]()
+	for _, value := range slice {
+		result = result.Append(value)
+	}
+	return result
+}
+
+func (v Vector[T]) Len() int {
+	return v.end - v.start
+}
+
+func (v Vector[T]) IsEmpty() bool {
+	return v.Len() == 0
+}
+
+// Panics if the index is out of range
+func (v Vector[T]) Get(index int) T {
+	v.checkIndex(index)
+	return v.get(v.start + index)
+}
+
+// Returns a new vector with the element at the given index replaced. Panics if
+// the index is out of range.
+func (v Vector[T]) Set(index int, value T) Vector[T] {
+	v.checkIndex(index)
+	return v.set(v.start+index, value)
+}
+
+// Returns a new vector with the values added to the end
+func (v Vector[T]) Append(values ...T) Vector[T] {
+	for _, value := range values {
+		if v.end < v.size {
+			// we're a view which ends before the underlying elements do, so we
+			// overwrite the next element rather than pushing a new one
+			v = v.set(v.end, value)
+		} else {
+			v = v.push(value)
+		}
+		v.end++
+	}
+	return v
+}
+
+// Returns a vector containing the elements from 'from' up to but not including
+// 'to', like slice[from:to]. This is O(1) as the result shares storage with
+// the original vector. Panics if the range is invalid.
+func (v Vector[T]) Slice(from int, to int) Vector[T] {
+	if from < 0 || to < from || to > v.Len() {
+		panic(fmt.Sprintf("persistent: slice bounds [%d:%d] out of range with length %d", from, to, v.Len()))
+	}
+	v.end = v.start + to
+	v.start += from
+	return v
+}
+
+func (v Vector[T]) ToSlice() []T {
+	result := make([]T, 0, v.Len())
+	v.ForEach(func(value T) {
+		result = append(result, value)
+	})
+	return result
+}
+
+func (v Vector[T]) ForEach(f func(T)) {
+	for i := v.start; i < v.end; {
+		leaf := v.leafFor(i)
+		for j := i & mask; j < len(leaf) && i < v.end; j++ {
+			f(leaf[j])
+			i++
+		}
+	}
+}
+
+func (v Vector[T]) checkIndex(index int) {
+	if index < 0 || index >= v.Len() {
+		panic(fmt.Sprintf("persistent: index %d out of range with length %d", index, v.Len()))
+	}
+}
+
+// Index of the first element held in the tail rather than the trie
+func (v Vector[T]) tailOffset() int {
+	if v.size < width {
+		return 0
+	}
+	return ((v.size - 1) >> bits) << bits
+}
+
+// Returns the values of the leaf (or tail) which holds the given index
+func (v Vector[T]) leafFor(index int) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= bits {
+		n = n.children[(index>>level)&mask]
+	}
+	return n.values
+}
+
+func (v Vector[T]) get(index int) T {
+	return v.leafFor(index)[index&mask]
+}
+
+func (v Vector[T]) set(index int, value T) Vector[T] {
+	if index >= v.tailOffset() {
+		tail := make([]T, len(v.tail))
+		copy(tail, v.tail)
+		tail[index&mask] = value
+		v.tail = tail
+		return v
+	}
+	v.root = setInNode(v.root, v.shift, index, value)
+	return v
+}
+
+func setInNode[T any](n *node[T], level uint, index int, value T) *node[T] {
+	result := n.clone()
+	if level == 0 {
+		result.values[index&mask] = value
+		return result
+	}
+	subIndex := (index >> level) & mask
+	result.children[subIndex] = setInNode(n.children[subIndex], level-bits, index, value)
+	return result
+}
+
+func (v Vector[T]) push(value T) Vector[T] {
+	if v.root == nil {
+		v.root = &node[T]{}
+		v.shift = bits
+	}
+
+	if v.size-v.tailOffset() < width {
+		tail := make([]T, len(v.tail), len(v.tail)+1)
+		copy(tail, v.tail)
+		v.tail = append(tail, value)
+		v.size++
+		return v
+	}
+
+	// the tail is full, so we move it into the trie and start a new one
+	tailNode := &node[T]{values: v.tail}
+	if (v.size >> bits) > (1 << v.shift) {
+		// the trie is full, so it gets a new root one level up
+		v.root = &node[T]{children: []*node[T]{v.root, newPath(v.shift, tailNode)}}
+		v.shift += bits
+	} else {
+		v.root = v.pushTail(v.shift, v.root, tailNode)
+	}
+	v.tail = []T{value}
+	v.size++
+	return v
+}
+
+func (v Vector[T]) pushTail(level uint, parent *node[T], tailNode *node[T]) *node[T] {
+	result := parent.clone()
+	subIndex := ((v.size - 1) >> level) & mask
+
+	var child *node[T]
+	if level == bits {
+		child = tailNode
+	} else if subIndex < len(parent.children) {
+		child = v.pushTail(level-bits, parent.children[subIndex], tailNode)
+	} else {
+		child = newPath(level-bits, tailNode)
+	}
+
+	if subIndex < len(result.children) {
+		result.children[subIndex] = child
+	} else {
+		result.children = append(result.children, child)
+	}
+	return result
+}
+
+// Wraps the node in branches until it reaches the given level
+func newPath[T any](level uint, n *node[T]) *node[T] {
+	if level == 0 {
+		return n
+	}
+	return &node[T]{children: []*node[T]{newPath(level-bits, n)}}
+}
+
+func (n *node[T]) clone() *node[T] {
+	result := &node[T]{}
+	if n.children != nil {
+		result.children = make([]*node[T], len(n.children), len(n.children)+1)
+		copy(result.children, n.children)
+	}
+	if n.values != nil {
+		result.values = make([]T, len(n.values))
+		copy(result.values, n.values)
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+package persistent
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestAppendGet(t *testing.T) {
+	// enough elements for a trie three levels deep
+	const size = 40000
+
+	vector := New[int]()
+	for i := 0; i < size; i++ {
+		vector = vector.Append(i)
+	}
+
+	if vector.Len() != size {
+		t.Errorf("Len() = %v, expected %v", vector.Len(), size)
+	}
+	for i := 0; i < size; i++ {
+		if vector.Get(i) != i {
+			t.Fatalf("Get(%v) = %v, expected %v", i, vector.Get(i), i)
+		}
+	}
+	slice := vector.ToSlice()
+	for i := 0; i < size; i++ {
+		if slice[i] != i {
+			t.Fatalf("ToSlice()[%v] = %v, expected %v", i, slice[i], i)
+		}
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var vector Vector[string]
+	if !vector.IsEmpty() {
+		t.Errorf("IsEmpty() = false, expected true")
+	}
+	vector = vector.Append("a", "b")
+	testutils.ExpectSlice(t, []string{"a", "b"}, vector.ToSlice())
+}
+
+func TestSnapshotsAreUnaffected(t *testing.T) {
+	original := NewFromSlice([]int{1, 2, 3})
+	appended := original.Append(4)
+	set := original.Set(0, 10)
+
+	testutils.ExpectSlice(t, []int{1, 2, 3}, original.ToSlice())
+	testutils.ExpectSlice(t, []int{1, 2, 3, 4}, appended.ToSlice())
+	testutils.ExpectSlice(t, []int{10, 2, 3}, set.ToSlice())
+
+	// appending to the same version twice must not clobber the first result
+	first := original.Append(5)
+	second := original.Append(6)
+	testutils.ExpectSlice(t, []int{1, 2, 3, 5}, first.ToSlice())
+	testutils.ExpectSlice(t, []int{1, 2, 3, 6}, second.ToSlice())
+}
+
+func TestSlice(t *testing.T) {
+	vector := NewFromSlice([]int{0, 1, 2, 3, 4, 5})
+	sliced := vector.Slice(1, 4)
+	testutils.ExpectSlice(t, []int{1, 2, 3}, sliced.ToSlice())
+	if sliced.Get(0) != 1 {
+		t.Errorf("Get(0) = %v, expected %v", sliced.Get(0), 1)
+	}
+
+	// appending to a slice must not affect the original, unlike Go's slices
+	appended := sliced.Append(10, 11, 12, 13)
+	testutils.ExpectSlice(t, []int{1, 2, 3, 10, 11, 12, 13}, appended.ToSlice())
+	testutils.ExpectSlice(t, []int{0, 1, 2, 3, 4, 5}, vector.ToSlice())
+	testutils.ExpectSlice(t, []int{1, 2, 3}, sliced.ToSlice())
+
+	testutils.ExpectSlice(t, []int{2}, sliced.Slice(1, 2).ToSlice())
+	testutils.ExpectSlice(t, []int{}, sliced.Slice(3, 3).ToSlice())
+}
+
+func TestSliceOfSliceThenAppend(t *testing.T) {
+	// enough elements that the tail starts after the first leaf
+	original := make([]int, 70)
+	for i := range original {
+		original[i] = i
+	}
+	vector := NewFromSlice(original)
+
+	tests := []struct {
+		name          string
+		outer         [2]int
+		inner         [2]int
+		appendValues  []int
+		expectedInner []int
+	}{
+		{"middle", [2]int{1, 5}, [2]int{1, 3}, []int{-1, -2}, []int{2, 3}},
+		{"to end", [2]int{60, 70}, [2]int{5, 10}, []int{-1}, []int{65, 66, 67, 68, 69}},
+		{"empty", [2]int{10, 20}, [2]int{4, 4}, []int{-1, -2, -3}, []int{}},
+		{"across leaf boundary", [2]int{30, 40}, [2]int{0, 3}, []int{-1, -2}, []int{30, 31, 32}},
+		{"whole vector", [2]int{0, 70}, [2]int{0, 70}, []int{-1}, original},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			outer := vector.Slice(test.outer[0], test.outer[1])
+			inner := outer.Slice(test.inner[0], test.inner[1])
+			appended := inner.Append(test.appendValues...)
+
+			testutils.ExpectSlice(t, append(append([]int{}, test.expectedInner...), test.appendValues...), appended.ToSlice())
+			// neither the vectors sliced from nor the sliced vector itself change
+			testutils.ExpectSlice(t, test.expectedInner, inner.ToSlice())
+			testutils.ExpectSlice(t, original[test.outer[0]:test.outer[1]], outer.ToSlice())
+			testutils.ExpectSlice(t, original, vector.ToSlice())
+		})
+	}
+}
+
+func TestPanics(t *testing.T) {
+	vector := NewFromSlice([]int{0, 1, 2})
+	panicTests := []func(){
+		func() { vector.Get(3) },
+		func() { vector.Get(-1) },
+		func() { vector.Set(3, 0) },
+		func() { vector.Slice(2, 1) },
+		func() { vector.Slice(0, 4) },
+		func() { vector.Slice(1, 2).Get(1) },
+	}
+	for _, f := range panicTests {
+		func() {
+			defer testutils.ExpectPanic(t)
+			f()
+		}()
+	}
+}
+
+// Applies random operations to both a vector and a plain slice, keeping every
+// version of each, and checks that all versions still agree at the end.
+func TestAgainstSliceModel(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	vectors := []Vector[int]{New[int]()}
+	models := [][]int{{}}
+
+	for i := 0; i < 3000; i++ {
+		index := random.Intn(len(vectors))
+		vector, model := vectors[index], models[index]
+
+		switch {
+		case random.Intn(10) < 6:
+			count := random.Intn(70)
+			values := make([]int, count)
+			for j := range values {
+				values[j] = random.Int()
+			}
+			vector = vector.Append(values...)
+			model = append(append([]int{}, model...), values...)
+		case len(model) > 0 && random.Intn(2) == 0:
+			position, value := random.Intn(len(model)), random.Int()
+			vector = vector.Set(position, value)
+			model = append([]int{}, model...)
+			model[position] = value
+		default:
+			from := random.Intn(len(model) + 1)
+			to := from + random.Intn(len(model)-from+1)
+			vector = vector.Slice(from, to)
+			model = model[from:to]
+		}
+
+		vectors = append(vectors, vector)
+		models = append(models, model)
+	}
+
+	for i := range vectors {
+		testutils.ExpectSlice(t, models[i], vectors[i].ToSlice())
+		for j := range models[i] {
+			if vectors[i].Get(j) != models[i][j] {
+				t.Fatalf("version %v: Get(%v) = %v, expected %v", i, j, vectors[i].Get(j), models[i][j])
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package slices
+
+import (
+	"iter"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// This file delegates the official slices package's iterator functions, so that we end up with a superset of the official API.
+// The signatures match the official ones exactly (see delegated_test.go).
+
+// All returns an iterator over index-value pairs in the slice
+// in the usual order.
+func All[Slice ~[]E, E any](s Slice) iter.Seq2[int, E] {
+	return slices.All(s)
+}
+
+// Backward returns an iterator over index-value pairs in the slice,
+// traversing it backward with descending indices.
+func Backward[Slice ~[]E, E any](s Slice) iter.Seq2[int, E] {
+	return slices.Backward(s)
+}
+
+// Values returns an iterator that yields the slice elements in order.
+func Values[Slice ~[]E, E any](s Slice) iter.Seq[E] {
+	return slices.Values(s)
+}
+
+// AppendSeq appends the values from seq to the slice and
+// returns the extended slice.
+// Intended usage is to assign the result back to the input slice.
+func AppendSeq[Slice ~[]E, E any](s Slice, seq iter.Seq[E]) Slice {
+	return slices.AppendSeq(s, seq)
+}
+
+// Collect collects values from seq into a new slice and returns it.
+func Collect[E any](seq iter.Seq[E]) []E {
+	return slices.Collect(seq)
+}
+
+// Sorted collects values from seq into a new slice, sorts the slice,
+// and returns it.
+func Sorted[E constraints.Ordered](seq iter.Seq[E]) []E {
+	return slices.Sorted(seq)
+}
+
+// SortedFunc collects values from seq into a new slice, sorts the slice
+// using the comparison function, and returns it.
+func SortedFunc[E any](seq iter.Seq[E], cmp func(E, E) int) []E {
+	return slices.SortedFunc(seq, cmp)
+}
+
+// SortedStableFunc collects values from seq into a new slice.
+// It then sorts the slice while keeping the original order of equal elements,
+// using the comparison function to compare elements.
+// It returns the new slice.
+func SortedStableFunc[E any](seq iter.Seq[E], cmp func(E, E) int) []E {
+	return slices.SortedStableFunc(seq, cmp)
+}
+
+// Chunk returns an iterator over consecutive sub-slices of up to n elements of s.
+// All but the last sub-slice will have size n.
+// All sub-slices are clipped to have no capacity beyond the length.
+// If s is empty, the sequence is empty: there is no empty slice in the sequence.
+// Chunk panics if n is less than 1.
+func Chunk[Slice ~[]E, E any](s Slice, n int) iter.Seq[Slice] {
+	return slices.Chunk(s, n)
+}
@@ -1,27 +1,32 @@
 package slices
 
 import (
+	"slices"
+	"sort"
+
 	"golang.org/x/exp/constraints"
-	"golang.org/x/exp/slices"
 )
 
 // This file delegates to the official slices package, so that we end up with a superset of the official API.
+// As in delegated_slices.go, functions which we had before the official package keep their original signatures.
 
 // Sort sorts a slice of any ordered type in ascending order.
+// When sorting floating-point numbers, NaNs are ordered before other values.
 func Sort[E constraints.Ordered](x []E) {
 	slices.Sort(x)
 }
 
-// Sort sorts the slice x in ascending order as determined by the less function.
-// This sort is not guaranteed to be stable.
-func SortFunc[E any](x []E, less func(a, b E) bool) {
-	slices.SortFunc(x, less)
+// SortFunc sorts the slice x in ascending order as determined by the cmp
+// function, which returns a negative number when a < b, a positive number when
+// a > b and zero when a == b. This sort is not guaranteed to be stable.
+func SortFunc[E any](x []E, cmp func(a, b E) int) {
+	slices.SortFunc(x, cmp)
 }
 
-// SortStable sorts the slice x while keeping the original order of equal
-// elements, using less to compare elements.
-func SortStableFunc[E any](x []E, less func(a, b E) bool) {
-	slices.SortStableFunc(x, less)
+// SortStableFunc sorts the slice x while keeping the original order of equal
+// elements, using cmp to compare elements in the same way as SortFunc.
+func SortStableFunc[E any](x []E, cmp func(a, b E) int) {
+	slices.SortStableFunc(x, cmp)
 }
 
 // IsSorted reports whether x is sorted in ascending order.
@@ -29,29 +34,75 @@ func IsSorted[E constraints.Ordered](x []E) bool {
 	return slices.IsSorted(x)
 }
 
-// IsSortedFunc reports whether x is sorted in ascending order, with less as the
-// comparison function.
-func IsSortedFunc[E any](x []E, less func(a, b E) bool) bool {
-	return slices.IsSortedFunc(x, less)
+// IsSortedFunc reports whether x is sorted in ascending order, with cmp as the
+// comparison function as defined by SortFunc.
+func IsSortedFunc[E any](x []E, cmp func(a, b E) int) bool {
+	return slices.IsSortedFunc(x, cmp)
 }
 
-// BinarySearch searches for target in a sorted slice and returns the smallest
-// index at which target is found. If the target is not found, the index at
-// which it could be inserted into the slice is returned; therefore, if the
-// intention is to find target itself a separate check for equality with the
-// element at the returned index is required.
+// BinarySearch searches for target in a sorted slice and returns the earliest
+// position where target is found, or the position where target would appear
+// in the sort order. The slice must be sorted in increasing order. Unlike the
+// official BinarySearch it doesn't say whether target was found; use
+// BinarySearchFunc with cmp.Compare for that.
 func BinarySearch[E constraints.Ordered](x []E, target E) int {
-	return slices.BinarySearch(x, target)
-}
-
-// BinarySearchFunc uses binary search to find and return the smallest index i
-// in [0, n) at which ok(i) is true, assuming that on the range [0, n),
-// ok(i) == true implies ok(i+1) == true. That is, BinarySearchFunc requires
-// that ok is false for some (possibly empty) prefix of the input range [0, n)
-// and then true for the (possibly empty) remainder; BinarySearchFunc returns
-// the first true index. If there is no such index, BinarySearchFunc returns n.
-// (Note that the "not found" return value is not -1 as in, for instance,
-// strings.Index.) Search calls ok(i) only for i in the range [0, n).
-func BinarySearchFunc[E any](x []E, ok func(E) bool) int {
-	return slices.BinarySearchFunc(x, ok)
+	index, _ := slices.BinarySearch(x, target)
+	return index
+}
+
+// BinarySearchFunc works like BinarySearch, but uses a custom comparison
+// function. The slice must be sorted in increasing order, where "increasing"
+// is defined by cmp. cmp should return 0 if the slice element matches the
+// target, a negative number if the slice element precedes the target, or a
+// positive number if the slice element follows the target. BinarySearchFunc
+// returns the position where target is found, or the position where it would
+// be inserted, and a bool saying whether the target is really found.
+func BinarySearchFunc[E, T any](x []E, target T, cmp func(E, T) int) (int, bool) {
+	return slices.BinarySearchFunc(x, target, cmp)
+}
+
+// The forms below take a less function or predicate, as SortFunc,
+// SortStableFunc, IsSortedFunc and BinarySearchFunc used to before the official
+// package switched to comparison functions.
+
+// Sorts the slice in ascending order as determined by less. This sort is not
+// guaranteed to be stable. Modifies the contents of the slice in place.
+func SortLessFunc[E any](x []E, less func(a, b E) bool) {
+	slices.SortFunc(x, cmpFromLess(less))
+}
+
+// Sorts the slice in ascending order as determined by less, keeping the
+// original order of equal elements. Modifies the contents of the slice in
+// place.
+func SortStableLessFunc[E any](x []E, less func(a, b E) bool) {
+	slices.SortStableFunc(x, cmpFromLess(less))
+}
+
+// Reports whether the slice is sorted in ascending order as determined by less
+func IsSortedLessFunc[E any](x []E, less func(a, b E) bool) bool {
+	for i := len(x) - 1; i > 0; i-- {
+		if less(x[i], x[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the smallest index i at which ok(x[i]) is true, assuming that ok is
+// false for some (possibly empty) prefix of the slice and true for the
+// remainder. If there is no such index, returns len(x).
+func BinarySearchPredicate[E any](x []E, ok func(E) bool) int {
+	return sort.Search(len(x), func(i int) bool { return ok(x[i]) })
+}
+
+func cmpFromLess[E any](less func(a, b E) bool) func(a, b E) int {
+	return func(a E, b E) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	}
 }
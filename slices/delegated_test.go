@@ -0,0 +1,97 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+// One entry per function in the official slices package which we forward to.
+// Each fails to compile if our function doesn't have the same type as the
+// official one. Those we had before the official package take plain slices
+// rather than a slice type parameter, so they are compared as instantiated for
+// []int.
+var forwarders = map[string]func(){
+	"All":       func() { testutils.SameSignature(All[[]int, int], slices.All[[]int, int]) },
+	"AppendSeq": func() { testutils.SameSignature(AppendSeq[[]int, int], slices.AppendSeq[[]int, int]) },
+	"Backward":  func() { testutils.SameSignature(Backward[[]int, int], slices.Backward[[]int, int]) },
+	"BinarySearchFunc": func() {
+		testutils.SameSignature(BinarySearchFunc[int, string], slices.BinarySearchFunc[[]int, int, string])
+	},
+	"Chunk":       func() { testutils.SameSignature(Chunk[[]int, int], slices.Chunk[[]int, int]) },
+	"Clip":        func() { testutils.SameSignature(Clip[[]int, int], slices.Clip[[]int, int]) },
+	"Clone":       func() { testutils.SameSignature(Clone[[]int, int], slices.Clone[[]int, int]) },
+	"Collect":     func() { testutils.SameSignature(Collect[int], slices.Collect[int]) },
+	"Compact":     func() { testutils.SameSignature(Compact[[]int, int], slices.Compact[[]int, int]) },
+	"CompactFunc": func() { testutils.SameSignature(CompactFunc[[]int, int], slices.CompactFunc[[]int, int]) },
+	"Compare":     func() { testutils.SameSignature(Compare[int], slices.Compare[[]int, int]) },
+	"CompareFunc": func() {
+		testutils.SameSignature(CompareFunc[int, string], slices.CompareFunc[[]int, []string, int, string])
+	},
+	"Contains":     func() { testutils.SameSignature(Contains[int], slices.Contains[[]int, int]) },
+	"ContainsFunc": func() { testutils.SameSignature(ContainsFunc[int], slices.ContainsFunc[[]int, int]) },
+	"Delete":       func() { testutils.SameSignature(Delete[[]int, int], slices.Delete[[]int, int]) },
+	"DeleteFunc":   func() { testutils.SameSignature(DeleteFunc[[]int, int], slices.DeleteFunc[[]int, int]) },
+	"Equal":        func() { testutils.SameSignature(Equal[int], slices.Equal[[]int, int]) },
+	"EqualFunc": func() {
+		testutils.SameSignature(EqualFunc[int, string], slices.EqualFunc[[]int, []string, int, string])
+	},
+	"Grow":             func() { testutils.SameSignature(Grow[[]int, int], slices.Grow[[]int, int]) },
+	"Index":            func() { testutils.SameSignature(Index[int], slices.Index[[]int, int]) },
+	"IndexFunc":        func() { testutils.SameSignature(IndexFunc[int], slices.IndexFunc[[]int, int]) },
+	"Insert":           func() { testutils.SameSignature(Insert[[]int, int], slices.Insert[[]int, int]) },
+	"IsSorted":         func() { testutils.SameSignature(IsSorted[int], slices.IsSorted[[]int, int]) },
+	"IsSortedFunc":     func() { testutils.SameSignature(IsSortedFunc[int], slices.IsSortedFunc[[]int, int]) },
+	"Max":              func() { testutils.SameSignature(Max[int], slices.Max[[]int, int]) },
+	"MaxFunc":          func() { testutils.SameSignature(MaxFunc[[]int, int], slices.MaxFunc[[]int, int]) },
+	"Min":              func() { testutils.SameSignature(Min[int], slices.Min[[]int, int]) },
+	"MinFunc":          func() { testutils.SameSignature(MinFunc[[]int, int], slices.MinFunc[[]int, int]) },
+	"Repeat":           func() { testutils.SameSignature(Repeat[[]int, int], slices.Repeat[[]int, int]) },
+	"Replace":          func() { testutils.SameSignature(Replace[[]int, int], slices.Replace[[]int, int]) },
+	"Sort":             func() { testutils.SameSignature(Sort[int], slices.Sort[[]int, int]) },
+	"SortFunc":         func() { testutils.SameSignature(SortFunc[int], slices.SortFunc[[]int, int]) },
+	"SortStableFunc":   func() { testutils.SameSignature(SortStableFunc[int], slices.SortStableFunc[[]int, int]) },
+	"Sorted":           func() { testutils.SameSignature(Sorted[int], slices.Sorted[int]) },
+	"SortedFunc":       func() { testutils.SameSignature(SortedFunc[int], slices.SortedFunc[int]) },
+	"SortedStableFunc": func() { testutils.SameSignature(SortedStableFunc[int], slices.SortedStableFunc[int]) },
+	"Values":           func() { testutils.SameSignature(Values[[]int, int], slices.Values[[]int, int]) },
+}
+
+// Official functions whose name we already used for something different
+// before the official package existed, along with the difference
+var differences = map[string]string{
+	"BinarySearch": "returns only the index; use BinarySearchFunc with cmp.Compare to learn whether the target was found",
+	"Concat":       "appends values to a slice; use Flatten to join slices",
+	"Reverse":      "returns a reversed copy; use ReverseInPlace to reverse in place",
+}
+
+// Ensures that whenever Go adds a function to the official slices package, a
+// forwarder is added here too.
+func TestForwarders(t *testing.T) {
+	for _, name := range testutils.StdlibFunctions(t, "slices") {
+		_, forwarded := forwarders[name]
+		_, different := differences[name]
+		if !forwarded && !different {
+			t.Errorf("slices.%s has no forwarder", name)
+		}
+	}
+}
+
+func TestIteratorForwarders(t *testing.T) {
+	slice := []int{3, 1, 2}
+	testutils.ExpectSlice(t, []int{1, 2, 3}, Sorted(Values(slice)))
+	testutils.ExpectSlice(t, []int{3, 2, 1}, SortedFunc(Values(slice), func(a int, b int) int { return b - a }))
+	testutils.ExpectSlice(t, []int{3, 1, 2, 4}, AppendSeq(Clone(slice), Values([]int{4})))
+
+	indices := []int{}
+	for i := range Backward(slice) {
+		indices = append(indices, i)
+	}
+	testutils.ExpectSlice(t, []int{2, 1, 0}, indices)
+
+	chunks := Collect(Chunk([]int{1, 2, 3, 4, 5}, 2))
+	if len(chunks) != 3 || !Equal(chunks[2], []int{5}) {
+		t.Errorf("Chunk() = %v, expected [[1 2] [3 4] [5]]", chunks)
+	}
+}
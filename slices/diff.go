@@ -0,0 +1,180 @@
+package slices
+
+// This file contains functions for computing the differences between two
+// slices, e.g. to work out which items in a refreshed list correspond to the
+// items previously displayed.
+
+type EditKind int
+
+const (
+	// The element is in both slices
+	EditEqual EditKind = iota
+	// The element is only in the first slice
+	EditDelete
+	// The element is only in the second slice
+	EditInsert
+)
+
+func (k EditKind) String() string {
+	switch k {
+	case EditEqual:
+		return "equal"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	default:
+		return "unknown"
+	}
+}
+
+// One step of an edit script turning slice a into slice b. AIndex and BIndex
+// are the positions in a and b which the step applies to. For a delete, BIndex
+// is where the deleted element would have been in b, and for an insert, AIndex
+// is where the inserted element would be in a.
+type Edit struct {
+	Kind   EditKind
+	AIndex int
+	BIndex int
+}
+
+// Returns a shortest edit script turning a into b, with one Edit per element of
+// each slice, using Myers' algorithm. Where an element is deleted and another
+// inserted at the same position, the delete comes first.
+//
+// This takes O((N+M)D) time and O(D^2) space, where D is the number of
+// inserts and deletes, so it is fast when the slices are similar.
+func Diff[E comparable](a []E, b []E) []Edit {
+	return DiffFunc(a, b, func(x E, y E) bool { return x == y })
+}
+
+// Like Diff but compares elements with eq, e.g. to match elements by ID.
+func DiffFunc[E any](a []E, b []E, eq func(E, E) bool) []Edit {
+	// common prefixes and suffixes are cheap to match up front, and are often
+	// most of the slices
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && eq(a[prefix], b[prefix]) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && eq(a[len(a)-1-suffix], b[len(b)-1-suffix]) {
+		suffix++
+	}
+
+	result := make([]Edit, 0, len(a)+len(b)-prefix-suffix)
+	for i := 0; i < prefix; i++ {
+		result = append(result, Edit{Kind: EditEqual, AIndex: i, BIndex: i})
+	}
+	for _, edit := range myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix], eq) {
+		edit.AIndex += prefix
+		edit.BIndex += prefix
+		result = append(result, edit)
+	}
+	for i := suffix; i > 0; i-- {
+		result = append(result, Edit{Kind: EditEqual, AIndex: len(a) - i, BIndex: len(b) - i})
+	}
+	return result
+}
+
+func myers[E any](a []E, b []E, eq func(E, E) bool) []Edit {
+	n, m := len(a), len(b)
+	// v[offset+k] holds the furthest x reached on diagonal k (where k = x - y)
+	offset := n + m
+	v := make([]int, 2*(n+m)+2)
+	// trace[d] holds diagonals -d to d of v as they were before step d, which
+	// is all we need to retrace step d
+	trace := [][]int{}
+
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, Clone(v[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				// moving down from diagonal k+1, i.e. inserting
+				x = v[offset+k+1]
+			} else {
+				// moving right from diagonal k-1, i.e. deleting
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eq(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, n, m)
+			}
+		}
+	}
+	// unreachable, as n+m steps is always enough
+	return nil
+}
+
+// Retraces the path found by myers from the end back to the start
+func backtrack(trace [][]int, n int, m int) []Edit {
+	result := []Edit{}
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		// v holds diagonals -d to d
+		at := func(k int) int { return v[k+d] }
+
+		k := x - y
+		prevX, prevY := 0, 0
+		if d > 0 {
+			prevK := k - 1
+			if k == -d || (k != d && at(k-1) < at(k+1)) {
+				prevK = k + 1
+			}
+			prevX = at(prevK)
+			prevY = prevX - prevK
+		}
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			result = append(result, Edit{Kind: EditEqual, AIndex: x, BIndex: y})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			y--
+			result = append(result, Edit{Kind: EditInsert, AIndex: x, BIndex: y})
+		} else {
+			x--
+			result = append(result, Edit{Kind: EditDelete, AIndex: x, BIndex: y})
+		}
+	}
+	ReverseInPlace(result)
+	return result
+}
+
+// Returns a longest sequence of elements which appear in both slices in the
+// same order, though not necessarily next to each other.
+func LongestCommonSubsequence[E comparable](a []E, b []E) []E {
+	return FilterMap(Diff(a, b), func(edit Edit) (E, bool) {
+		if edit.Kind != EditEqual {
+			return zero[E](), false
+		}
+		return a[edit.AIndex], true
+	})
+}
+
+// Applies an edit script produced by Diff or DiffFunc to a, returning a slice
+// equal to b. Elements matched by an equal edit are taken from a, so with
+// DiffFunc this keeps the old versions of matching elements. Produces a new
+// slice, leaves the input slices untouched.
+func ApplyEdits[E any](a []E, b []E, edits []Edit) []E {
+	result := make([]E, 0, len(b))
+	for _, edit := range edits {
+		switch edit.Kind {
+		case EditEqual:
+			result = append(result, a[edit.AIndex])
+		case EditInsert:
+			result = append(result, b[edit.BIndex])
+		}
+	}
+	return result
+}
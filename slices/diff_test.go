@@ -0,0 +1,158 @@
+package slices
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected string
+	}{
+		{"", "", ""},
+		{"abc", "abc", "=a =b =c"},
+		{"", "ab", "+a +b"},
+		{"ab", "", "-a -b"},
+		{"a", "b", "-a +b"},
+		{"abcabba", "cbabac", "-a -b =c +b =a =b -b =a +c"},
+		{"xaby", "xcby", "=x -a +c =b =y"},
+	}
+	for _, test := range tests {
+		a, b := strings.Split(test.a, ""), strings.Split(test.b, "")
+		edits := Diff(a, b)
+		result := strings.Join(Map(edits, func(edit Edit) string {
+			switch edit.Kind {
+			case EditEqual:
+				return "=" + a[edit.AIndex]
+			case EditDelete:
+				return "-" + a[edit.AIndex]
+			default:
+				return "+" + b[edit.BIndex]
+			}
+		}), " ")
+		if result != test.expected {
+			t.Errorf("Diff(%q, %q) = %q, expected %q", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestDiffIndices(t *testing.T) {
+	expected := []Edit{
+		{Kind: EditEqual, AIndex: 0, BIndex: 0},
+		{Kind: EditDelete, AIndex: 1, BIndex: 1},
+		{Kind: EditInsert, AIndex: 2, BIndex: 1},
+		{Kind: EditInsert, AIndex: 2, BIndex: 2},
+		{Kind: EditEqual, AIndex: 2, BIndex: 3},
+	}
+	result := Diff([]int{1, 2, 3}, []int{1, 4, 5, 3})
+	if !Equal(expected, result) {
+		t.Errorf("Diff() = %v, expected %v", result, expected)
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	type commit struct {
+		sha      string
+		selected bool
+	}
+	old := []commit{{"a", false}, {"b", true}, {"c", false}}
+	refreshed := []commit{{"z", false}, {"a", false}, {"b", false}, {"c", false}}
+
+	edits := DiffFunc(old, refreshed, func(x commit, y commit) bool { return x.sha == y.sha })
+	result := ApplyEdits(old, refreshed, edits)
+
+	// the matched commits keep their old state
+	if !Equal(result, []commit{{"z", false}, {"a", false}, {"b", true}, {"c", false}}) {
+		t.Errorf("ApplyEdits() = %v", result)
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	tests := []struct {
+		a        []int
+		b        []int
+		expected []int
+	}{
+		{[]int{}, []int{1}, []int{}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{1, 2, 3, 4}, []int{2, 4, 5}, []int{2, 4}},
+		{[]int{1, 2}, []int{3, 4}, []int{}},
+	}
+	for _, test := range tests {
+		testutils.ExpectSlice(t, test.expected, LongestCommonSubsequence(test.a, test.b))
+	}
+}
+
+// Checks on random inputs that the edit script covers both slices in order,
+// reproduces b, puts deletes before inserts, and is as short as possible,
+// comparing against the LCS length computed by dynamic programming.
+func TestDiffRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	randomSlice := func() []int {
+		result := make([]int, random.Intn(30))
+		for i := range result {
+			result[i] = random.Intn(4)
+		}
+		return result
+	}
+
+	for i := 0; i < 1000; i++ {
+		a, b := randomSlice(), randomSlice()
+		edits := Diff(a, b)
+
+		testutils.ExpectSlice(t, b, ApplyEdits(a, b, edits))
+
+		x, y, equal := 0, 0, 0
+		for j, edit := range edits {
+			if edit.AIndex != x || edit.BIndex != y {
+				t.Fatalf("Diff(%v, %v): edit %v is %v, expected indices %v, %v", a, b, j, edit, x, y)
+			}
+			switch edit.Kind {
+			case EditEqual:
+				if a[x] != b[y] {
+					t.Fatalf("Diff(%v, %v): edit %v matches unequal elements", a, b, j)
+				}
+				x++
+				y++
+				equal++
+			case EditDelete:
+				if j > 0 && edits[j-1].Kind == EditInsert {
+					t.Fatalf("Diff(%v, %v): edit %v is a delete following an insert", a, b, j)
+				}
+				x++
+			case EditInsert:
+				y++
+			}
+		}
+		if x != len(a) || y != len(b) {
+			t.Fatalf("Diff(%v, %v) stopped at %v, %v", a, b, x, y)
+		}
+		if equal != lcsLength(a, b) {
+			t.Fatalf("Diff(%v, %v) matched %v elements, expected %v", a, b, equal, lcsLength(a, b))
+		}
+	}
+}
+
+func lcsLength(a []int, b []int) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] > lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	return lengths[0][0]
+}
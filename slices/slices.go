@@ -1,8 +1,10 @@
 package slices
 
 import (
+	"fmt"
+	"math/bits"
+
 	"golang.org/x/exp/constraints"
-	"golang.org/x/exp/slices"
 )
 
 // This file contains the new functions that do not live in the official slices package.
@@ -174,7 +176,8 @@ func FilterInPlace[T any](slice []T, test func(T) bool) []T {
 	return slice[:newLength]
 }
 
-// Produces a new slice, leaves the input slice untouched
+// Produces a new slice, leaves the input slice untouched. (The official Reverse
+// works in place instead, like ReverseInPlace.)
 func Reverse[T any](slice []T) []T {
 	result := make([]T, len(slice))
 	for i := range slice {
@@ -253,7 +256,7 @@ func Prepend[T any](slice []T, values ...T) []T {
 
 // Removes the element at the given index. Intended usage is to reassign the result to the input slice.
 func Remove[T any](slice []T, index int) []T {
-	return slices.Delete(slice, index, index+1)
+	return Delete(slice, index, index+1)
 }
 
 // Removes the element at the 'fromIndex' and then inserts it at 'toIndex'.
@@ -261,7 +264,7 @@ func Remove[T any](slice []T, index int) []T {
 func Move[T any](slice []T, fromIndex int, toIndex int) []T {
 	item := slice[fromIndex]
 	slice = Remove(slice, fromIndex)
-	return slices.Insert(slice, toIndex, item)
+	return Insert(slice, toIndex, item)
 }
 
 // Swaps two elements at the given indices.
@@ -270,7 +273,8 @@ func Swap[T any](slice []T, index1 int, index2 int) {
 	slice[index1], slice[index2] = slice[index2], slice[index1]
 }
 
-// Similar to Append but we leave the original slice untouched and return a new slice
+// Similar to Append but we leave the original slice untouched and return a new
+// slice. (The official Concat joins several slices instead, like Flatten.)
 func Concat[T any](slice []T, values ...T) []T {
 	newSlice := make([]T, 0, len(slice)+len(values))
 	newSlice = append(newSlice, slice...)
@@ -278,10 +282,6 @@ func Concat[T any](slice []T, values ...T) []T {
 	return newSlice
 }
 
-func ContainsFunc[T any](slice []T, f func(T) bool) bool {
-	return IndexFunc(slice, f) != -1
-}
-
 // Pops item from the end of the slice and returns it, along with the updated slice
 // Mutates original slice. Intended usage is to reassign the slice result to the input slice.
 func Pop[T any](slice []T) (T, []T) {
@@ -299,6 +299,42 @@ func Shift[T any](slice []T) (T, []T) {
 	return value, slice
 }
 
+// Like Pop but returns false rather than panicking if the slice is empty.
+// Mutates original slice. Intended usage is to reassign the slice result to the input slice.
+func PopOK[T any](slice []T) (T, []T, bool) {
+	if len(slice) == 0 {
+		return zero[T](), slice, false
+	}
+	value, slice := Pop(slice)
+	return value, slice, true
+}
+
+// Like Shift but returns false rather than panicking if the slice is empty.
+// Mutates original slice. Intended usage is to reassign the slice result to the input slice.
+func ShiftOK[T any](slice []T) (T, []T, bool) {
+	if len(slice) == 0 {
+		return zero[T](), slice, false
+	}
+	value, slice := Shift(slice)
+	return value, slice, true
+}
+
+// Returns the first element of the slice, or false if the slice is empty.
+func First[T any](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		return zero[T](), false
+	}
+	return slice[0], true
+}
+
+// Returns the last element of the slice, or false if the slice is empty.
+func Last[T any](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		return zero[T](), false
+	}
+	return slice[len(slice)-1], true
+}
+
 func Partition[T any](slice []T, test func(T) bool) ([]T, []T) {
 	left := make([]T, 0, len(slice))
 	right := make([]T, 0, len(slice))
@@ -314,6 +350,107 @@ func Partition[T any](slice []T, test func(T) bool) ([]T, []T) {
 	return left, right
 }
 
+// Groups the elements by the key returned by f. Elements within each group keep
+// their original order.
+func GroupBy[T any, K comparable](slice []T, f func(T) K) map[K][]T {
+	result := map[K][]T{}
+	for _, element := range slice {
+		key := f(element)
+		result[key] = append(result[key], element)
+	}
+	return result
+}
+
+// Combines the elements at each index of the two slices using f. If the slices
+// have different lengths, the extra elements of the longer one are ignored.
+func Zip[A any, B any, V any](a []A, b []B, f func(A, B) V) []V {
+	length := len(a)
+	if len(b) < length {
+		length = len(b)
+	}
+	result := make([]V, 0, length)
+	for i := 0; i < length; i++ {
+		result = append(result, f(a[i], b[i]))
+	}
+	return result
+}
+
+// Combines the elements into a single value by calling f with the value so far
+// and each element in turn, starting with the first element. Returns false if
+// the slice is empty.
+// E.g. Reduce([]int{1,2,3}, func(acc int, v int) int { return acc - v }) = -4, true
+func Reduce[T any](slice []T, f func(T, T) T) (T, bool) {
+	if len(slice) == 0 {
+		return zero[T](), false
+	}
+	return Fold(slice[1:], slice[0], f), true
+}
+
+// Like Reduce but works from the last element to the first.
+// E.g. ReduceRight([]int{1,2,3}, func(acc int, v int) int { return acc - v }) = 0, true
+func ReduceRight[T any](slice []T, f func(T, T) T) (T, bool) {
+	if len(slice) == 0 {
+		return zero[T](), false
+	}
+	result := slice[len(slice)-1]
+	for i := len(slice) - 2; i >= 0; i-- {
+		result = f(result, slice[i])
+	}
+	return result, true
+}
+
+// Like Reduce but stops at the first error f returns.
+func TryReduce[T any](slice []T, f func(T, T) (T, error)) (T, bool, error) {
+	if len(slice) == 0 {
+		return zero[T](), false, nil
+	}
+	result, err := TryFold(slice[1:], slice[0], f)
+	if err != nil {
+		return zero[T](), false, err
+	}
+	return result, true, nil
+}
+
+// Combines the elements into a single value by calling f with the value so far
+// and each element in turn, starting from 'initial'. Unlike Reduce, the value
+// may have a different type from the elements.
+// E.g. Fold([]int{1,2,3}, "", func(acc string, v int) string { return acc + strconv.Itoa(v) }) = "123"
+func Fold[T any, V any](slice []T, initial V, f func(V, T) V) V {
+	result := initial
+	for _, element := range slice {
+		result = f(result, element)
+	}
+	return result
+}
+
+// Like Fold but stops at the first error f returns.
+func TryFold[T any, V any](slice []T, initial V, f func(V, T) (V, error)) (V, error) {
+	result := initial
+	for _, element := range slice {
+		var err error
+		result, err = f(result, element)
+		if err != nil {
+			return zero[V](), err
+		}
+	}
+	return result, nil
+}
+
+// Like Fold but returns the value after each element, so the last value in the
+// result is the value Fold would return.
+// E.g. Scan([]int{1,2,3}, 0, func(acc int, v int) int { return acc + v }) = []int{1, 3, 6}
+func Scan[T any, V any](slice []T, initial V, f func(V, T) V) []V {
+	result := make([]V, 0, len(slice))
+	value := initial
+	for _, element := range slice {
+		value = f(value, element)
+		result = append(result, value)
+	}
+	return result
+}
+
+// Returns the greatest key produced by f, or the zero value if the slice is
+// empty. Use MaxElemBy to get the element itself or to detect an empty slice.
 func MaxBy[T any, V constraints.Ordered](slice []T, f func(T) V) V {
 	if len(slice) == 0 {
 		return zero[V]()
@@ -329,6 +466,8 @@ func MaxBy[T any, V constraints.Ordered](slice []T, f func(T) V) V {
 	return max
 }
 
+// Returns the least key produced by f, or the zero value if the slice is empty.
+// Use MinElemBy to get the element itself or to detect an empty slice.
 func MinBy[T any, V constraints.Ordered](slice []T, f func(T) V) V {
 	if len(slice) == 0 {
 		return zero[V]()
@@ -344,6 +483,394 @@ func MinBy[T any, V constraints.Ordered](slice []T, f func(T) V) V {
 	return min
 }
 
+// Returns the element with the greatest key produced by f, along with its
+// index. If several elements share the greatest key, the first is returned.
+// Returns false if the slice is empty.
+func MaxElemBy[T any, V constraints.Ordered](slice []T, f func(T) V) (T, int, bool) {
+	if len(slice) == 0 {
+		return zero[T](), -1, false
+	}
+
+	index := 0
+	max := f(slice[0])
+	for i, element := range slice[1:] {
+		if value := f(element); value > max {
+			index, max = i+1, value
+		}
+	}
+	return slice[index], index, true
+}
+
+// Returns the element with the least key produced by f, along with its index.
+// If several elements share the least key, the first is returned. Returns false
+// if the slice is empty.
+func MinElemBy[T any, V constraints.Ordered](slice []T, f func(T) V) (T, int, bool) {
+	if len(slice) == 0 {
+		return zero[T](), -1, false
+	}
+
+	index := 0
+	min := f(slice[0])
+	for i, element := range slice[1:] {
+		if value := f(element); value < min {
+			index, min = i+1, value
+		}
+	}
+	return slice[index], index, true
+}
+
+// Returns the elements with the least and greatest keys produced by f, calling
+// f once per element. Ties are broken as in MinElemBy and MaxElemBy. Returns
+// false if the slice is empty.
+func MinMaxBy[T any, V constraints.Ordered](slice []T, f func(T) V) (T, T, bool) {
+	if len(slice) == 0 {
+		return zero[T](), zero[T](), false
+	}
+
+	minIndex, maxIndex := 0, 0
+	min := f(slice[0])
+	max := min
+	for i, element := range slice[1:] {
+		value := f(element)
+		if value < min {
+			minIndex, min = i+1, value
+		}
+		if value > max {
+			maxIndex, max = i+1, value
+		}
+	}
+	return slice[minIndex], slice[maxIndex], true
+}
+
+// Returns the index of the greatest element, or -1 if the slice is empty. If
+// several elements are equally great, the index of the first is returned.
+func ArgMax[E constraints.Ordered](s []E) int {
+	_, index, _ := MaxElemBy(s, identity[E])
+	return index
+}
+
+// Returns the index of the least element, or -1 if the slice is empty. If
+// several elements are equally small, the index of the first is returned.
+func ArgMin[E constraints.Ordered](s []E) int {
+	_, index, _ := MinElemBy(s, identity[E])
+	return index
+}
+
+func identity[T any](value T) T {
+	return value
+}
+
+// The following functions treat slices as sets while keeping the order of
+// their elements. Each result contains no duplicates, and its elements are in
+// the order they first appear in the first slice (followed by the second, for
+// Union and SymmetricDifference). They take O(len(a) + len(b)) time.
+
+// Returns the elements of a which are also in b.
+func Intersect[E comparable](a []E, b []E) []E {
+	return IntersectBy(a, b, identity[E])
+}
+
+// Returns the elements of a which are not in b.
+func Difference[E comparable](a []E, b []E) []E {
+	return DifferenceBy(a, b, identity[E])
+}
+
+// Returns the elements which are in a or b.
+func Union[E comparable](a []E, b []E) []E {
+	return UnionBy(a, b, identity[E])
+}
+
+// Returns the elements which are in exactly one of a and b.
+func SymmetricDifference[E comparable](a []E, b []E) []E {
+	return SymmetricDifferenceBy(a, b, identity[E])
+}
+
+// Like Intersect but compares elements by the key returned by f. Where several
+// elements share a key, the first is kept.
+func IntersectBy[T any, K comparable](a []T, b []T, f func(T) K) []T {
+	inB := keySet(b, f)
+	seen := map[K]bool{}
+	return Filter(a, func(element T) bool {
+		key := f(element)
+		if !inB[key] || seen[key] {
+			return false
+		}
+		seen[key] = true
+		return true
+	})
+}
+
+// Like Difference but compares elements by the key returned by f. Where several
+// elements share a key, the first is kept.
+func DifferenceBy[T any, K comparable](a []T, b []T, f func(T) K) []T {
+	seen := keySet(b, f)
+	return Filter(a, func(element T) bool {
+		key := f(element)
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+		return true
+	})
+}
+
+// Like Union but compares elements by the key returned by f. Where several
+// elements share a key, the first is kept.
+func UnionBy[T any, K comparable](a []T, b []T, f func(T) K) []T {
+	return DifferenceBy(Concat(a, b...), nil, f)
+}
+
+// Like SymmetricDifference but compares elements by the key returned by f.
+// Where several elements share a key, the first is kept.
+func SymmetricDifferenceBy[T any, K comparable](a []T, b []T, f func(T) K) []T {
+	return Concat(DifferenceBy(a, b, f), DifferenceBy(b, a, f)...)
+}
+
+func keySet[T any, K comparable](slice []T, f func(T) K) map[K]bool {
+	result := make(map[K]bool, len(slice))
+	for _, element := range slice {
+		result[f(element)] = true
+	}
+	return result
+}
+
+// The following functions are like the above but expect both slices to be
+// sorted in ascending order, and produce a sorted result by merging them in
+// linear time without allocating a map.
+
+// Like Intersect, for sorted slices.
+func IntersectSorted[E constraints.Ordered](a []E, b []E) []E {
+	return mergeSorted(a, b, false, true, false)
+}
+
+// Like Difference, for sorted slices.
+func DifferenceSorted[E constraints.Ordered](a []E, b []E) []E {
+	return mergeSorted(a, b, true, false, false)
+}
+
+// Like Union, for sorted slices.
+func UnionSorted[E constraints.Ordered](a []E, b []E) []E {
+	return mergeSorted(a, b, true, true, true)
+}
+
+// Like SymmetricDifference, for sorted slices.
+func SymmetricDifferenceSorted[E constraints.Ordered](a []E, b []E) []E {
+	return mergeSorted(a, b, true, false, true)
+}
+
+// Merges the sorted slices, keeping the elements only in a, in both, and only in
+// b as requested, without duplicates.
+func mergeSorted[E constraints.Ordered](a []E, b []E, onlyA bool, both bool, onlyB bool) []E {
+	result := []E{}
+	add := func(value E) {
+		if len(result) == 0 || result[len(result)-1] != value {
+			result = append(result, value)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			if onlyA {
+				add(a[i])
+			}
+			i++
+		case b[j] < a[i]:
+			if onlyB {
+				add(b[j])
+			}
+			j++
+		default:
+			if both {
+				add(a[i])
+			}
+			// skipping every copy of the shared value, so that a duplicate in
+			// one slice isn't mistaken for a value only in that slice
+			value := a[i]
+			for i < len(a) && a[i] == value {
+				i++
+			}
+			for j < len(b) && b[j] == value {
+				j++
+			}
+		}
+	}
+	if onlyA {
+		for ; i < len(a); i++ {
+			add(a[i])
+		}
+	}
+	if onlyB {
+		for ; j < len(b); j++ {
+			add(b[j])
+		}
+	}
+	return result
+}
+
+// Returns the k greatest elements according to cmp, which compares elements in
+// the same way as for SortFunc, greatest first, in O(n log k) time. If k
+// exceeds the length of the slice, all elements are returned. The order of
+// equal elements is unspecified. Produces a new slice, leaves the input slice
+// untouched.
+func TopK[T any](slice []T, k int, cmp func(a T, b T) int) []T {
+	if k > len(slice) {
+		k = len(slice)
+	}
+	if k <= 0 {
+		return []T{}
+	}
+
+	// a min-heap of the k greatest elements so far, so that the root is the
+	// one to evict when we find a greater element
+	less := Comparator[T](cmp).Less
+	greater := func(a T, b T) bool { return less(b, a) }
+	result := make([]T, k)
+	copy(result, slice[:k])
+	heapify(result, greater)
+	for _, value := range slice[k:] {
+		if less(result[0], value) {
+			result[0] = value
+			siftDown(result, 0, greater)
+		}
+	}
+
+	heapSort(result, greater)
+	return result
+}
+
+// Returns the k least elements according to cmp, least first, in O(n log k)
+// time. If k exceeds the length of the slice, all elements are returned. The
+// order of equal elements is unspecified. Produces a new slice, leaves the input
+// slice untouched.
+func BottomK[T any](slice []T, k int, cmp func(a T, b T) int) []T {
+	return TopK(slice, k, Comparator[T](cmp).Reverse())
+}
+
+// Rearranges the slice so that its first k elements are its k least elements
+// according to cmp, in sorted order. The order of the remaining elements is
+// unspecified. Takes
+// O(n log k) time, compared to O(n log n) for a full sort. If k exceeds the
+// length of the slice, the whole slice is sorted. Modifies the contents of the
+// slice in place.
+func PartialSort[T any](slice []T, k int, cmp func(a T, b T) int) {
+	if k > len(slice) {
+		k = len(slice)
+	}
+	if k <= 0 {
+		return
+	}
+	less := Comparator[T](cmp).Less
+
+	// a max-heap of the k least elements so far
+	heap := slice[:k]
+	heapify(heap, less)
+	for i := k; i < len(slice); i++ {
+		if less(slice[i], heap[0]) {
+			heap[0], slice[i] = slice[i], heap[0]
+			siftDown(heap, 0, less)
+		}
+	}
+	heapSort(heap, less)
+}
+
+// Rearranges the slice so that the element at index n is the one which would be
+// there if the slice were sorted according to cmp, with no element before it
+// greater and no element after it less. Takes O(n) time on average using
+// quickselect. Panics if n is out of range. Modifies the contents of the slice
+// in place.
+func NthElement[T any](slice []T, n int, cmp func(a T, b T) int) {
+	if n < 0 || n >= len(slice) {
+		panic(fmt.Sprintf("slices: NthElement index %d out of range with length %d", n, len(slice)))
+	}
+
+	less := Comparator[T](cmp).Less
+	lo, hi := 0, len(slice)
+	// If quickselect keeps picking bad pivots, we fall back to a partial sort so
+	// that the worst case is O(n log n) rather than O(n^2).
+	budget := 2 * bits.Len(uint(len(slice)))
+	for hi-lo > 1 {
+		if budget == 0 {
+			PartialSort(slice[lo:hi], n-lo+1, cmp)
+			return
+		}
+		budget--
+
+		pivot := partition(slice[lo:hi], less) + lo
+		switch {
+		case n < pivot:
+			hi = pivot
+		case n > pivot:
+			lo = pivot + 1
+		default:
+			return
+		}
+	}
+}
+
+// Partitions the slice around the median of its first, middle and last
+// elements, returning the pivot's final index.
+func partition[T any](slice []T, less func(a T, b T) bool) int {
+	last := len(slice) - 1
+	mid := last / 2
+	// ordering the three candidates so the median ends up at mid
+	if less(slice[mid], slice[0]) {
+		slice[mid], slice[0] = slice[0], slice[mid]
+	}
+	if less(slice[last], slice[0]) {
+		slice[last], slice[0] = slice[0], slice[last]
+	}
+	if less(slice[last], slice[mid]) {
+		slice[last], slice[mid] = slice[mid], slice[last]
+	}
+	slice[mid], slice[last] = slice[last], slice[mid]
+
+	pivot := slice[last]
+	store := 0
+	for i := 0; i < last; i++ {
+		if less(slice[i], pivot) {
+			slice[i], slice[store] = slice[store], slice[i]
+			store++
+		}
+	}
+	slice[store], slice[last] = slice[last], slice[store]
+	return store
+}
+
+// Arranges the slice into a heap whose root is its greatest element according
+// to less.
+func heapify[T any](heap []T, less func(a T, b T) bool) {
+	for i := len(heap)/2 - 1; i >= 0; i-- {
+		siftDown(heap, i, less)
+	}
+}
+
+func siftDown[T any](heap []T, index int, less func(a T, b T) bool) {
+	for {
+		child := 2*index + 1
+		if child >= len(heap) {
+			return
+		}
+		if right := child + 1; right < len(heap) && less(heap[child], heap[right]) {
+			child = right
+		}
+		if !less(heap[index], heap[child]) {
+			return
+		}
+		heap[index], heap[child] = heap[child], heap[index]
+		index = child
+	}
+}
+
+// Sorts a heap built by heapify into ascending order according to less.
+func heapSort[T any](heap []T, less func(a T, b T) bool) {
+	for end := len(heap) - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		siftDown(heap[:end], 0, less)
+	}
+}
+
 func Find[T any](slice []T, f func(T) bool) (T, bool) {
 	for _, element := range slice {
 		if f(element) {
@@ -394,7 +921,9 @@ func TryForEachWithIndex[T any](slice []T, f func(T, int) error) error {
 	return nil
 }
 
-func Sum[T constraints.Ordered](i []T) T {
+// Adds up the elements. For float slices where accuracy matters, see
+// numeric.KahanSum.
+func Sum[T constraints.Integer | constraints.Float](i []T) T {
 	sum := zero[T]()
 	for _, value := range i {
 		sum += value
@@ -402,6 +931,15 @@ func Sum[T constraints.Ordered](i []T) T {
 	return sum
 }
 
+// Adds up the values produced by f for each element.
+func SumBy[T any, V constraints.Integer | constraints.Float](slice []T, f func(T) V) V {
+	sum := zero[V]()
+	for _, element := range slice {
+		sum += f(element)
+	}
+	return sum
+}
+
 func zero[T any]() T {
 	var value T
 	return value
@@ -1,11 +1,16 @@
 package slices
 
 import (
+	"cmp"
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/jesseduffield/generics/internal/testutils"
-	"golang.org/x/exp/slices"
 )
 
 func TestInsert(t *testing.T) {
@@ -706,18 +711,424 @@ func TestSumInt(t *testing.T) {
 	}
 }
 
-func TestSumString(t *testing.T) {
+func TestSumBy(t *testing.T) {
 	tests := []struct {
 		slice    []string
-		expected string
+		expected int
 	}{
-		{[]string{}, ""},
-		{[]string{"a"}, "a"},
-		{[]string{"a", "b"}, "ab"},
+		{[]string{}, 0},
+		{[]string{"a"}, 1},
+		{[]string{"a", "bcd"}, 4},
 	}
 	for _, test := range tests {
-		if Sum(test.slice) != test.expected {
-			t.Errorf("Sum(%v) = %v, expected %v", test.slice, Sum(test.slice), test.expected)
+		result := SumBy(test.slice, func(value string) int { return len(value) })
+		if result != test.expected {
+			t.Errorf("SumBy(%v) = %v, expected %v", test.slice, result, test.expected)
+		}
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	result := GroupBy([]string{"apple", "avocado", "banana", "apricot"}, func(value string) byte { return value[0] })
+	if len(result) != 2 {
+		t.Errorf("Expected 2 groups, got %v", result)
+	}
+	testutils.ExpectSlice(t, []string{"apple", "avocado", "apricot"}, result['a'])
+	testutils.ExpectSlice(t, []string{"banana"}, result['b'])
+
+	if len(GroupBy([]int{}, func(value int) int { return value })) != 0 {
+		t.Errorf("Expected no groups for empty slice")
+	}
+}
+
+func TestZip(t *testing.T) {
+	join := func(a int, b string) string { return strconv.Itoa(a) + b }
+	tests := []struct {
+		a        []int
+		b        []string
+		expected []string
+	}{
+		{[]int{}, []string{}, []string{}},
+		{[]int{1, 2}, []string{"a", "b"}, []string{"1a", "2b"}},
+		{[]int{1, 2, 3}, []string{"a"}, []string{"1a"}},
+		{[]int{1}, []string{"a", "b"}, []string{"1a"}},
+	}
+	for _, test := range tests {
+		testutils.ExpectSlice(t, test.expected, Zip(test.a, test.b, join))
+	}
+}
+
+func TestReduce(t *testing.T) {
+	subtract := func(acc int, value int) int { return acc - value }
+	tests := []struct {
+		slice         []int
+		expected      int
+		expectedRight int
+		expectedOK    bool
+	}{
+		{[]int{}, 0, 0, false},
+		{[]int{1}, 1, 1, true},
+		{[]int{1, 2, 3}, -4, 0, true},
+	}
+	for _, test := range tests {
+		result, ok := Reduce(test.slice, subtract)
+		if result != test.expected || ok != test.expectedOK {
+			t.Errorf("Reduce(%v) = %v, %v, expected %v, %v", test.slice, result, ok, test.expected, test.expectedOK)
+		}
+		result, ok = ReduceRight(test.slice, subtract)
+		if result != test.expectedRight || ok != test.expectedOK {
+			t.Errorf("ReduceRight(%v) = %v, %v, expected %v, %v", test.slice, result, ok, test.expectedRight, test.expectedOK)
+		}
+	}
+}
+
+func TestTryReduce(t *testing.T) {
+	add := func(acc int, value int) (int, error) {
+		if value < 0 {
+			return 0, errors.New("negative value")
+		}
+		return acc + value, nil
+	}
+
+	result, ok, err := TryReduce([]int{1, 2, 3}, add)
+	testutils.ExpectNilError(t, err)
+	if result != 6 || !ok {
+		t.Errorf("TryReduce() = %v, %v, expected %v, %v", result, ok, 6, true)
+	}
+
+	_, ok, err = TryReduce([]int{}, add)
+	testutils.ExpectNilError(t, err)
+	if ok {
+		t.Errorf("TryReduce() on empty slice returned true")
+	}
+
+	_, ok, err = TryReduce([]int{1, -2, 3}, add)
+	testutils.ExpectError(t, err, "negative value")
+	if ok {
+		t.Errorf("TryReduce() with error returned true")
+	}
+}
+
+func TestFold(t *testing.T) {
+	concat := func(acc string, value int) string { return acc + strconv.Itoa(value) }
+	tests := []struct {
+		slice        []int
+		expected     string
+		expectedScan []string
+	}{
+		{[]int{}, ">", []string{}},
+		{[]int{1}, ">1", []string{">1"}},
+		{[]int{1, 2, 3}, ">123", []string{">1", ">12", ">123"}},
+	}
+	for _, test := range tests {
+		result := Fold(test.slice, ">", concat)
+		if result != test.expected {
+			t.Errorf("Fold(%v) = %v, expected %v", test.slice, result, test.expected)
+		}
+		testutils.ExpectSlice(t, test.expectedScan, Scan(test.slice, ">", concat))
+	}
+}
+
+func TestTryFold(t *testing.T) {
+	concat := func(acc string, value int) (string, error) {
+		if value < 0 {
+			return "", errors.New("negative value")
+		}
+		return acc + strconv.Itoa(value), nil
+	}
+
+	result, err := TryFold([]int{1, 2}, ">", concat)
+	testutils.ExpectNilError(t, err)
+	if result != ">12" {
+		t.Errorf("TryFold() = %v, expected %v", result, ">12")
+	}
+
+	_, err = TryFold([]int{1, -2}, ">", concat)
+	testutils.ExpectError(t, err, "negative value")
+}
+
+func TestPopOK(t *testing.T) {
+	value, slice, ok := PopOK([]int{1, 2})
+	if !ok || value != 2 {
+		t.Errorf("PopOK = %v, %v, expected %v, %v", value, ok, 2, true)
+	}
+	testutils.ExpectSlice(t, []int{1}, slice)
+
+	value, slice, ok = PopOK([]int{})
+	if ok || value != 0 {
+		t.Errorf("PopOK = %v, %v, expected %v, %v", value, ok, 0, false)
+	}
+	testutils.ExpectSlice(t, []int{}, slice)
+}
+
+func TestShiftOK(t *testing.T) {
+	value, slice, ok := ShiftOK([]int{1, 2})
+	if !ok || value != 1 {
+		t.Errorf("ShiftOK = %v, %v, expected %v, %v", value, ok, 1, true)
+	}
+	testutils.ExpectSlice(t, []int{2}, slice)
+
+	value, slice, ok = ShiftOK([]int{})
+	if ok || value != 0 {
+		t.Errorf("ShiftOK = %v, %v, expected %v, %v", value, ok, 0, false)
+	}
+	testutils.ExpectSlice(t, []int{}, slice)
+}
+
+func TestFirstLast(t *testing.T) {
+	tests := []struct {
+		slice       []int
+		first, last int
+		ok          bool
+	}{
+		{[]int{}, 0, 0, false},
+		{[]int{1}, 1, 1, true},
+		{[]int{1, 2, 3}, 1, 3, true},
+	}
+	for _, test := range tests {
+		first, ok := First(test.slice)
+		if first != test.first || ok != test.ok {
+			t.Errorf("First(%v) = %v, %v, expected %v, %v", test.slice, first, ok, test.first, test.ok)
+		}
+		last, ok := Last(test.slice)
+		if last != test.last || ok != test.ok {
+			t.Errorf("Last(%v) = %v, %v, expected %v, %v", test.slice, last, ok, test.last, test.ok)
+		}
+	}
+}
+
+func TestTopKBottomK(t *testing.T) {
+	tests := []struct {
+		slice          []int
+		k              int
+		expectedTop    []int
+		expectedBottom []int
+	}{
+		{[]int{}, 2, []int{}, []int{}},
+		{[]int{5, 1, 4}, 0, []int{}, []int{}},
+		{[]int{5, 1, 4}, -1, []int{}, []int{}},
+		{[]int{5, 1, 4, 2, 3}, 2, []int{5, 4}, []int{1, 2}},
+		{[]int{5, 1, 4, 2, 3}, 5, []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		{[]int{2, 1}, 10, []int{2, 1}, []int{1, 2}},
+		{[]int{3, 3, 1, 3}, 2, []int{3, 3}, []int{1, 3}},
+	}
+	for _, test := range tests {
+		original := Clone(test.slice)
+		testutils.ExpectSlice(t, test.expectedTop, TopK(test.slice, test.k, cmp.Compare[int]))
+		testutils.ExpectSlice(t, test.expectedBottom, BottomK(test.slice, test.k, cmp.Compare[int]))
+		testutils.ExpectSlice(t, original, test.slice)
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	tests := []struct {
+		slice    []int
+		k        int
+		expected []int
+	}{
+		{[]int{}, 2, []int{}},
+		{[]int{3, 1, 2}, 0, []int{}},
+		{[]int{5, 1, 4, 2, 3}, 2, []int{1, 2}},
+		{[]int{5, 1, 4, 2, 3}, 5, []int{1, 2, 3, 4, 5}},
+		{[]int{2, 1}, 3, []int{1, 2}},
+	}
+	for _, test := range tests {
+		slice := Clone(test.slice)
+		PartialSort(slice, test.k, cmp.Compare[int])
+		prefix := slice[:len(test.expected)]
+		testutils.ExpectSlice(t, test.expected, prefix)
+
+		// the rest are the remaining elements in some order
+		rest := Clone(slice[len(prefix):])
+		Sort(rest)
+		all := Clone(test.slice)
+		Sort(all)
+		testutils.ExpectSlice(t, all[len(prefix):], rest)
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		// small values so that there are plenty of duplicates
+		slice := make([]int, random.Intn(100)+1)
+		for j := range slice {
+			slice[j] = random.Intn(20)
+		}
+		sorted := Clone(slice)
+		Sort(sorted)
+
+		n := random.Intn(len(slice))
+		NthElement(slice, n, cmp.Compare[int])
+		if slice[n] != sorted[n] {
+			t.Fatalf("NthElement(%v) put %v at index %v, expected %v", n, slice[n], n, sorted[n])
+		}
+		for j := range slice {
+			if (j < n && slice[j] > slice[n]) || (j > n && slice[j] < slice[n]) {
+				t.Fatalf("NthElement(%v) left %v at index %v on the wrong side of %v", n, slice[j], j, slice[n])
+			}
+		}
+	}
+
+	// all-equal and sorted inputs exercise the fallback from quickselect
+	equal := make([]int, 1000)
+	NthElement(equal, 500, cmp.Compare[int])
+	sorted := make([]int, 1000)
+	for i := range sorted {
+		sorted[i] = i
+	}
+	NthElement(sorted, 999, cmp.Compare[int])
+	if sorted[999] != 999 {
+		t.Errorf("NthElement(999) on sorted input = %v, expected %v", sorted[999], 999)
+	}
+
+	for _, n := range []int{-1, 3} {
+		func() {
+			defer testutils.ExpectPanic(t)
+			NthElement([]int{1, 2, 3}, n, cmp.Compare[int])
+		}()
+	}
+}
+
+func TestElemBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	tests := []struct {
+		slice       []string
+		expectedMin string
+		minIndex    int
+		expectedMax string
+		maxIndex    int
+		expectedOK  bool
+	}{
+		{[]string{}, "", -1, "", -1, false},
+		{[]string{"a"}, "a", 0, "a", 0, true},
+		{[]string{"bb", "a", "ccc", "d", "eee"}, "a", 1, "ccc", 2, true},
+		{[]string{"", "x"}, "", 0, "x", 1, true},
+	}
+	for _, test := range tests {
+		element, index, ok := MinElemBy(test.slice, length)
+		if element != test.expectedMin || index != test.minIndex || ok != test.expectedOK {
+			t.Errorf("MinElemBy(%v) = %q, %v, %v, expected %q, %v, %v", test.slice, element, index, ok, test.expectedMin, test.minIndex, test.expectedOK)
+		}
+		element, index, ok = MaxElemBy(test.slice, length)
+		if element != test.expectedMax || index != test.maxIndex || ok != test.expectedOK {
+			t.Errorf("MaxElemBy(%v) = %q, %v, %v, expected %q, %v, %v", test.slice, element, index, ok, test.expectedMax, test.maxIndex, test.expectedOK)
+		}
+		min, max, ok := MinMaxBy(test.slice, length)
+		if min != test.expectedMin || max != test.expectedMax || ok != test.expectedOK {
+			t.Errorf("MinMaxBy(%v) = %q, %q, %v, expected %q, %q, %v", test.slice, min, max, ok, test.expectedMin, test.expectedMax, test.expectedOK)
+		}
+	}
+}
+
+func TestArgMinMax(t *testing.T) {
+	tests := []struct {
+		slice          []int
+		expectedArgMin int
+		expectedArgMax int
+	}{
+		{[]int{}, -1, -1},
+		{[]int{3}, 0, 0},
+		{[]int{3, 1, 4, 1, 5, 5}, 1, 4},
+	}
+	for _, test := range tests {
+		if result := ArgMin(test.slice); result != test.expectedArgMin {
+			t.Errorf("ArgMin(%v) = %v, expected %v", test.slice, result, test.expectedArgMin)
 		}
+		if result := ArgMax(test.slice); result != test.expectedArgMax {
+			t.Errorf("ArgMax(%v) = %v, expected %v", test.slice, result, test.expectedArgMax)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if result := Min([]int{3, 1, 4}); result != 1 {
+		t.Errorf("Min() = %v, expected %v", result, 1)
+	}
+	if result := Max([]string{"b", "c", "a"}); result != "c" {
+		t.Errorf("Max() = %v, expected %v", result, "c")
+	}
+
+	nan := math.NaN()
+	if result := Max([]float64{1, nan, 2}); !math.IsNaN(result) {
+		t.Errorf("Max() with NaN = %v, expected NaN", result)
+	}
+	if result := Min([]float64{1, 2, nan}); !math.IsNaN(result) {
+		t.Errorf("Min() with NaN = %v, expected NaN", result)
+	}
+
+	for _, f := range []func([]int) int{Min[int], Max[int]} {
+		func() {
+			defer testutils.ExpectPanic(t)
+			f([]int{})
+		}()
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	tests := []struct {
+		a                   []string
+		b                   []string
+		intersect           []string
+		difference          []string
+		union               []string
+		symmetricDifference []string
+	}{
+		{[]string{}, []string{}, []string{}, []string{}, []string{}, []string{}},
+		{[]string{"a"}, []string{}, []string{}, []string{"a"}, []string{"a"}, []string{"a"}},
+		{[]string{}, []string{"a"}, []string{}, []string{}, []string{"a"}, []string{"a"}},
+		{
+			[]string{"main", "feature", "bugfix", "feature"},
+			[]string{"release", "bugfix", "main"},
+			[]string{"main", "bugfix"},
+			[]string{"feature"},
+			[]string{"main", "feature", "bugfix", "release"},
+			[]string{"feature", "release"},
+		},
+	}
+	for _, test := range tests {
+		testutils.ExpectSlice(t, test.intersect, Intersect(test.a, test.b))
+		testutils.ExpectSlice(t, test.difference, Difference(test.a, test.b))
+		testutils.ExpectSlice(t, test.union, Union(test.a, test.b))
+		testutils.ExpectSlice(t, test.symmetricDifference, SymmetricDifference(test.a, test.b))
+	}
+}
+
+func TestSetOperationsBy(t *testing.T) {
+	lower := strings.ToLower
+	a := []string{"Main", "feature", "MAIN", "Bugfix"}
+	b := []string{"bugfix", "main", "Release"}
+
+	testutils.ExpectSlice(t, []string{"Main", "Bugfix"}, IntersectBy(a, b, lower))
+	testutils.ExpectSlice(t, []string{"feature"}, DifferenceBy(a, b, lower))
+	testutils.ExpectSlice(t, []string{"Main", "feature", "Bugfix", "Release"}, UnionBy(a, b, lower))
+	testutils.ExpectSlice(t, []string{"feature", "Release"}, SymmetricDifferenceBy(a, b, lower))
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	tests := []struct {
+		a []int
+		b []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1, 2, 3}, []int{}},
+		{[]int{}, []int{1, 1}},
+		{[]int{1, 2, 2, 4, 6}, []int{2, 3, 4, 4, 7}},
+		{[]int{1, 1, 1}, []int{1}},
+		{[]int{5, 6}, []int{1, 2}},
+	}
+	// the sorted versions should agree with the general ones, which keep the
+	// order of sorted inputs
+	for _, test := range tests {
+		testutils.ExpectSlice(t, Intersect(test.a, test.b), IntersectSorted(test.a, test.b))
+		testutils.ExpectSlice(t, Difference(test.a, test.b), DifferenceSorted(test.a, test.b))
+
+		union := Union(test.a, test.b)
+		Sort(union)
+		testutils.ExpectSlice(t, union, UnionSorted(test.a, test.b))
+
+		symmetricDifference := SymmetricDifference(test.a, test.b)
+		Sort(symmetricDifference)
+		testutils.ExpectSlice(t, symmetricDifference, SymmetricDifferenceSorted(test.a, test.b))
 	}
 }
@@ -0,0 +1,61 @@
+package slices
+
+import "cmp"
+
+// This file contains helpers for sorting by keys rather than writing out nested
+// comparisons by hand, e.g.
+//
+//	slices.SortFunc(people, slices.By(lastName).ThenBy(slices.By(age).Reverse()))
+
+// A comparison function in the style of the official slices package: it
+// returns a negative number when a sorts before b, a positive number when a
+// sorts after b, and zero when they are equivalent. It can be passed anywhere a
+// func(a, b T) int is expected.
+type Comparator[T any] func(a T, b T) int
+
+// Returns a Comparator ordering elements by the key returned by f, in ascending
+// order. NaN keys sort before all other keys.
+func By[T any, K cmp.Ordered](f func(T) K) Comparator[T] {
+	return func(a T, b T) int {
+		return cmp.Compare(f(a), f(b))
+	}
+}
+
+// Returns a Comparator which uses next to break ties between elements which c
+// considers equivalent. Go methods can't introduce type parameters, so next is
+// itself a Comparator, typically made with By.
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a T, b T) int {
+		if result := c(a, b); result != 0 {
+			return result
+		}
+		return next(a, b)
+	}
+}
+
+// Returns a Comparator with the opposite order to c
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a T, b T) int {
+		return c(b, a)
+	}
+}
+
+// Reports whether a sorts before b, for functions taking a less function such
+// as SortLessFunc
+func (c Comparator[T]) Less(a T, b T) bool {
+	return c(a, b) < 0
+}
+
+// Sorts the slice in ascending order of the key returned by f. This sort is not
+// guaranteed to be stable. f is called O(n log n) times, so it should be cheap.
+// Modifies the contents of the slice in place.
+func SortBy[T any, K cmp.Ordered](slice []T, f func(T) K) {
+	SortFunc(slice, By(f))
+}
+
+// Sorts the slice in ascending order of the key returned by f, keeping the
+// original order of elements with equal keys. Modifies the contents of the
+// slice in place.
+func SortStableBy[T any, K cmp.Ordered](slice []T, f func(T) K) {
+	SortStableFunc(slice, By(f))
+}
@@ -0,0 +1,103 @@
+package slices
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestComparator(t *testing.T) {
+	people := []person{{"bob", 30}, {"alice", 30}, {"carol", 25}, {"alice", 20}}
+	name := func(p person) string { return p.name }
+	age := func(p person) int { return p.age }
+
+	tests := []struct {
+		cmp      Comparator[person]
+		expected []person
+	}{
+		{By(name).ThenBy(By(age)), []person{{"alice", 20}, {"alice", 30}, {"bob", 30}, {"carol", 25}}},
+		{By(age).ThenBy(By(name)), []person{{"alice", 20}, {"carol", 25}, {"alice", 30}, {"bob", 30}}},
+		{By(age).Reverse().ThenBy(By(name)), []person{{"alice", 30}, {"bob", 30}, {"carol", 25}, {"alice", 20}}},
+		{By(name).ThenBy(By(age)).Reverse(), []person{{"carol", 25}, {"bob", 30}, {"alice", 30}, {"alice", 20}}},
+	}
+	for _, test := range tests {
+		slice := Clone(people)
+		SortFunc(slice, test.cmp)
+		testutils.ExpectSlice(t, test.expected, slice)
+		if !IsSortedLessFunc(slice, test.cmp.Less) {
+			t.Errorf("IsSortedLessFunc(%v) = false, expected true", slice)
+		}
+	}
+}
+
+func TestByNaN(t *testing.T) {
+	slice := []float64{2, math.NaN(), 1}
+	SortBy(slice, identity[float64])
+	if !math.IsNaN(slice[0]) || slice[1] != 1 || slice[2] != 2 {
+		t.Errorf("SortBy = %v, expected [NaN 1 2]", slice)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	slice := []string{"ccc", "a", "bb"}
+	SortBy(slice, func(value string) int { return len(value) })
+	testutils.ExpectSlice(t, []string{"a", "bb", "ccc"}, slice)
+}
+
+func TestSortStableBy(t *testing.T) {
+	slice := []person{{"bob", 30}, {"alice", 20}, {"carol", 30}, {"dave", 20}}
+	SortStableBy(slice, func(p person) int { return p.age })
+	testutils.ExpectSlice(t, []person{{"alice", 20}, {"dave", 20}, {"bob", 30}, {"carol", 30}}, slice)
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	slice := []person{{"alice", 20}, {"bob", 25}, {"carol", 30}}
+	cmp := func(p person, age int) int { return p.age - age }
+	tests := []struct {
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}{
+		{10, 0, false},
+		{20, 0, true},
+		{27, 2, false},
+		{30, 2, true},
+		{40, 3, false},
+	}
+	for _, test := range tests {
+		index, found := BinarySearchFunc(slice, test.target, cmp)
+		if index != test.expectedIndex || found != test.expectedFound {
+			t.Errorf("BinarySearchFunc(%v) = %v, %v, expected %v, %v", test.target, index, found, test.expectedIndex, test.expectedFound)
+		}
+	}
+
+	index := BinarySearchPredicate(slice, func(p person) bool { return p.age >= 27 })
+	if index != 2 {
+		t.Errorf("BinarySearchPredicate = %v, expected %v", index, 2)
+	}
+}
+
+func lessInt(a int, b int) bool {
+	return a < b
+}
+
+func TestLessForms(t *testing.T) {
+	slice := []int{3, 1, 2}
+	SortLessFunc(slice, func(a int, b int) bool { return a > b })
+	testutils.ExpectSlice(t, []int{3, 2, 1}, slice)
+
+	SortStableLessFunc(slice, lessInt)
+	testutils.ExpectSlice(t, []int{1, 2, 3}, slice)
+	if IsSortedLessFunc([]int{2, 1}, lessInt) {
+		t.Errorf("IsSortedLessFunc([2 1]) = true, expected false")
+	}
+	if !IsSortedFunc(slice, func(a int, b int) int { return a - b }) {
+		t.Errorf("IsSortedFunc(%v) = false, expected true", slice)
+	}
+}
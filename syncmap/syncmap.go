@@ -0,0 +1,213 @@
+package syncmap
+
+import (
+	"hash/maphash"
+	"math"
+	"sync"
+	"unsafe"
+)
+
+// Map is a goroutine-safe map. Unlike sync.Map it is type-safe, and rather than
+// having a single lock it splits its entries across shards which are locked
+// independently, so that goroutines touching different keys rarely contend.
+type Map[K comparable, V any] struct {
+	shards []*shard[K, V]
+	hash   func(K) uint64
+}
+
+type shard[K comparable, V any] struct {
+	mutex   sync.RWMutex
+	hashMap map[K]V
+	// keeps shards on separate cache lines so that locking one shard doesn't
+	// slow down goroutines using its neighbours
+	_ [128 - unsafe.Sizeof(sync.RWMutex{}) - unsafe.Sizeof(map[int]int{})]byte
+}
+
+// must be a power of two
+const shardCount = 32
+
+// Creates a map which hashes keys with a default hash function. The default
+// handles strings, integers and floats directly, and hashes other key types
+// with maphash.Comparable, so pointers are hashed by address and structs by
+// their fields' values, as a built-in map would.
+func New[K comparable, V any]() *Map[K, V] {
+	return NewWithHasher[K, V](defaultHash[K])
+}
+
+// Creates a map which uses the given function to assign keys to shards. Equal
+// keys must have equal hashes.
+func NewWithHasher[K comparable, V any](hash func(K) uint64) *Map[K, V] {
+	shards := make([]*shard[K, V], shardCount)
+	for i := range shards {
+		shards[i] = &shard[K, V]{hashMap: make(map[K]V)}
+	}
+	return &Map[K, V]{shards: shards, hash: hash}
+}
+
+func (m *Map[K, V]) shardFor(key K) *shard[K, V] {
+	return m.shards[mix(m.hash(key))&(shardCount-1)]
+}
+
+func (m *Map[K, V]) Load(key K) (V, bool) {
+	s := m.shardFor(key)
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	value, ok := s.hashMap[key]
+	return value, ok
+}
+
+func (m *Map[K, V]) Store(key K, value V) {
+	s := m.shardFor(key)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.hashMap[key] = value
+}
+
+// Returns the existing value for the key if present. Otherwise, it stores and
+// returns the given value. The loaded result is true if the value was loaded,
+// false if stored.
+func (m *Map[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	s := m.shardFor(key)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if existing, ok := s.hashMap[key]; ok {
+		return existing, true
+	}
+	s.hashMap[key] = value
+	return value, false
+}
+
+// Deletes the value for a key, returning the previous value if any.
+func (m *Map[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shardFor(key)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	value, ok := s.hashMap[key]
+	delete(s.hashMap, key)
+	return value, ok
+}
+
+func (m *Map[K, V]) Delete(key K) {
+	m.LoadAndDelete(key)
+}
+
+// Atomically updates the value for a key. f receives the current value and
+// whether it's present, and returns the new value and whether to keep it:
+// returning false deletes the key. Compute returns the result of f.
+// f must not call methods on the map, or it will deadlock.
+func (m *Map[K, V]) Compute(key K, f func(value V, ok bool) (V, bool)) (V, bool) {
+	s := m.shardFor(key)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	oldValue, ok := s.hashMap[key]
+	newValue, keep := f(oldValue, ok)
+	if keep {
+		s.hashMap[key] = newValue
+	} else {
+		delete(s.hashMap, key)
+	}
+	return newValue, keep
+}
+
+// Calls f for each entry until f returns false. Each shard is copied before
+// being iterated, so f may safely call methods on the map. As with sync.Map,
+// Range does not correspond to a consistent snapshot of the whole map.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	for _, s := range m.shards {
+		s.mutex.RLock()
+		keys := make([]K, 0, len(s.hashMap))
+		values := make([]V, 0, len(s.hashMap))
+		for key, value := range s.hashMap {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+		s.mutex.RUnlock()
+
+		for i := range keys {
+			if !f(keys[i], values[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (m *Map[K, V]) Len() int {
+	total := 0
+	for _, s := range m.shards {
+		s.mutex.RLock()
+		total += len(s.hashMap)
+		s.mutex.RUnlock()
+	}
+	return total
+}
+
+var seed = maphash.MakeSeed()
+
+func defaultHash[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return hashString(k)
+	case int:
+		return uint64(k)
+	case int8:
+		return uint64(k)
+	case int16:
+		return uint64(k)
+	case int32:
+		return uint64(k)
+	case int64:
+		return uint64(k)
+	case uint:
+		return uint64(k)
+	case uint8:
+		return uint64(k)
+	case uint16:
+		return uint64(k)
+	case uint32:
+		return uint64(k)
+	case uint64:
+		return k
+	case uintptr:
+		return uint64(k)
+	case float32:
+		return hashFloat(float64(k))
+	case float64:
+		return hashFloat(k)
+	default:
+		return maphash.Comparable(seed, key)
+	}
+}
+
+// FNV-1a
+func hashString(s string) uint64 {
+	hash := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		hash ^= uint64(s[i])
+		hash *= 1099511628211
+	}
+	return hash
+}
+
+func hashFloat(f float64) uint64 {
+	// 0 and -0 are equal but have different bits
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// Spreads the bits of the hash so that sequential integer keys don't all land
+// in the low shards (splitmix64 finaliser).
+func mix(hash uint64) uint64 {
+	hash ^= hash >> 30
+	hash *= 0xbf58476d1ce4e5b9
+	hash ^= hash >> 27
+	hash *= 0x94d049bb133111eb
+	hash ^= hash >> 31
+	return hash
+}
@@ -0,0 +1,256 @@
+package syncmap
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/jesseduffield/generics/internal/testutils"
+	"github.com/jesseduffield/generics/slices"
+)
+
+func TestLoadStore(t *testing.T) {
+	m := New[string, int]()
+	m.Store("a", 1)
+	m.Store("b", 2)
+	m.Store("a", 3)
+
+	value, ok := m.Load("a")
+	if !ok || value != 3 {
+		t.Errorf("Load(a) = %v, %v, expected %v, %v", value, ok, 3, true)
+	}
+
+	value, ok = m.Load("c")
+	if ok || value != 0 {
+		t.Errorf("Load(c) = %v, %v, expected %v, %v", value, ok, 0, false)
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("Len() = %v, expected %v", m.Len(), 2)
+	}
+}
+
+func TestLoadOrStore(t *testing.T) {
+	m := New[string, int]()
+
+	value, loaded := m.LoadOrStore("a", 1)
+	if loaded || value != 1 {
+		t.Errorf("LoadOrStore(a, 1) = %v, %v, expected %v, %v", value, loaded, 1, false)
+	}
+
+	value, loaded = m.LoadOrStore("a", 2)
+	if !loaded || value != 1 {
+		t.Errorf("LoadOrStore(a, 2) = %v, %v, expected %v, %v", value, loaded, 1, true)
+	}
+}
+
+func TestLoadAndDelete(t *testing.T) {
+	m := New[int, string]()
+	m.Store(1, "a")
+
+	value, ok := m.LoadAndDelete(1)
+	if !ok || value != "a" {
+		t.Errorf("LoadAndDelete(1) = %v, %v, expected %v, %v", value, ok, "a", true)
+	}
+
+	value, ok = m.LoadAndDelete(1)
+	if ok || value != "" {
+		t.Errorf("LoadAndDelete(1) = %v, %v, expected %v, %v", value, ok, "", false)
+	}
+
+	if m.Len() != 0 {
+		t.Errorf("Len() = %v, expected %v", m.Len(), 0)
+	}
+}
+
+func TestCompute(t *testing.T) {
+	m := New[string, int]()
+	increment := func(value int, ok bool) (int, bool) { return value + 1, true }
+
+	m.Compute("a", increment)
+	value, _ := m.Compute("a", increment)
+	if value != 2 {
+		t.Errorf("Compute(a) = %v, expected %v", value, 2)
+	}
+
+	_, keep := m.Compute("a", func(value int, ok bool) (int, bool) { return 0, false })
+	if keep {
+		t.Errorf("Compute(a) returned keep = true, expected false")
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Errorf("Compute did not delete key")
+	}
+}
+
+func TestRange(t *testing.T) {
+	m := New[int, int]()
+	for i := 0; i < 100; i++ {
+		m.Store(i, i*2)
+	}
+
+	keys := []int{}
+	m.Range(func(key int, value int) bool {
+		if value != key*2 {
+			t.Errorf("Range gave value %v for key %v", value, key)
+		}
+		keys = append(keys, key)
+		// mutating the map from within Range must not deadlock
+		m.Store(key, value)
+		return true
+	})
+	slices.Sort(keys)
+	testutils.ExpectSlice(t, slices.MapWithIndex(keys, func(_ int, i int) int { return i }), keys)
+
+	count := 0
+	m.Range(func(key int, value int) bool {
+		count++
+		return count < 10
+	})
+	if count != 10 {
+		t.Errorf("Range called f %v times, expected %v", count, 10)
+	}
+}
+
+func TestCustomKeys(t *testing.T) {
+	type point struct{ x, y int }
+
+	m := New[point, string]()
+	m.Store(point{1, 2}, "a")
+	value, ok := m.Load(point{1, 2})
+	if !ok || value != "a" {
+		t.Errorf("Load = %v, %v, expected %v, %v", value, ok, "a", true)
+	}
+
+	hashed := NewWithHasher[point, string](func(p point) uint64 { return uint64(p.x*31 + p.y) })
+	hashed.Store(point{1, 2}, "a")
+	value, ok = hashed.Load(point{1, 2})
+	if !ok || value != "a" {
+		t.Errorf("Load = %v, %v, expected %v, %v", value, ok, "a", true)
+	}
+
+	floats := New[float64, string]()
+	floats.Store(0.0, "zero")
+	zero := 0.0
+	negativeZero := -zero
+	if _, ok := floats.Load(negativeZero); !ok {
+		t.Errorf("Load(-0) did not find value stored at 0")
+	}
+
+	type wrapper struct{ f float64 }
+	wrapped := New[wrapper, string]()
+	wrapped.Store(wrapper{zero}, "zero")
+	if _, ok := wrapped.Load(wrapper{negativeZero}); !ok {
+		t.Errorf("Load({-0}) did not find value stored at {0}")
+	}
+}
+
+func TestPointerKeys(t *testing.T) {
+	m := New[*int, string]()
+	keys := make([]*int, 100)
+	for i := range keys {
+		keys[i] = new(int)
+		m.Store(keys[i], "a")
+	}
+
+	// pointers are hashed by address, so changing their targets mustn't matter
+	for i, key := range keys {
+		*key = i + 1
+	}
+	for _, key := range keys {
+		if _, ok := m.Load(key); !ok {
+			t.Errorf("Load did not find pointer key after its target changed")
+		}
+	}
+
+	other := 1
+	if _, ok := m.Load(&other); ok {
+		t.Errorf("Load found a pointer key that was never stored")
+	}
+}
+
+func TestConcurrentCompute(t *testing.T) {
+	m := New[string, int]()
+	keys := []string{"a", "b", "c", "d"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				m.Compute(keys[j%len(keys)], func(value int, ok bool) (int, bool) { return value + 1, true })
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, key := range keys {
+		value, _ := m.Load(key)
+		if value != 2000 {
+			t.Errorf("Load(%v) = %v, expected %v", key, value, 2000)
+		}
+	}
+}
+
+type mutexMap[K comparable, V any] struct {
+	mutex   sync.RWMutex
+	hashMap map[K]V
+}
+
+func (m *mutexMap[K, V]) Load(key K) (V, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	value, ok := m.hashMap[key]
+	return value, ok
+}
+
+func (m *mutexMap[K, V]) Store(key K, value V) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.hashMap[key] = value
+}
+
+const benchmarkKeyCount = 1024
+
+func benchmarkKeys() []string {
+	keys := make([]string, benchmarkKeyCount)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	return keys
+}
+
+// 1 in 4 operations is a write
+func BenchmarkShardedMap(b *testing.B) {
+	m := New[string, int]()
+	keys := benchmarkKeys()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keys[i%benchmarkKeyCount]
+			if i%4 == 0 {
+				m.Store(key, i)
+			} else {
+				m.Load(key)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkMutexMap(b *testing.B) {
+	m := &mutexMap[string, int]{hashMap: map[string]int{}}
+	keys := benchmarkKeys()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keys[i%benchmarkKeyCount]
+			if i%4 == 0 {
+				m.Store(key, i)
+			} else {
+				m.Load(key)
+			}
+			i++
+		}
+	})
+}